@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE workflow_execution_metrics
+(
+    id                      serial PRIMARY KEY,
+    workflow_execution_id   integer NOT NULL REFERENCES workflow_executions ON DELETE CASCADE,
+    pod_name                text NOT NULL CHECK(pod_name <> ''),
+    name                    text NOT NULL CHECK(name <> ''),
+    value                   double precision NOT NULL,
+    format                  text NOT NULL DEFAULT '',
+    step                    bigint NOT NULL DEFAULT 0,
+    timestamp               timestamp NOT NULL,
+
+    -- auditing info
+    created_at              timestamp NOT NULL DEFAULT (NOW() at time zone 'utc')
+);
+
+CREATE INDEX workflow_execution_metrics_workflow_execution_id_pod_name_idx ON workflow_execution_metrics (workflow_execution_id, pod_name, id);
+
+-- +goose Down
+DROP TABLE workflow_execution_metrics;
@@ -0,0 +1,40 @@
+-- +goose Up
+CREATE TABLE sweeps
+(
+    id                              serial PRIMARY KEY,
+    uid                             varchar(30) NOT NULL CHECK(uid <> ''),
+    name                            text NOT NULL CHECK(name <> ''),
+    namespace                       varchar(30) NOT NULL,
+    phase                           varchar(50) NOT NULL,
+    strategy                        varchar(50) NOT NULL,
+
+    workflow_template_version_id    integer NOT NULL REFERENCES workflow_template_versions ON DELETE CASCADE,
+
+    -- parameters are passed to every execution, trials hold the swept values of each execution
+    parameters                      jsonb NOT NULL,
+    sweep_parameters                jsonb NOT NULL,
+    trials                          jsonb NOT NULL,
+    max_parallelism                 integer NOT NULL,
+    launched_executions             integer NOT NULL DEFAULT 0,
+    completed_executions            integer NOT NULL DEFAULT 0,
+
+    objective_metric                text NOT NULL DEFAULT '',
+    objective_goal                  varchar(50) NOT NULL DEFAULT '',
+    early_stop_threshold            double precision,
+    best_workflow_execution_uid     text,
+    best_metric_value               double precision,
+    -- failed_trials holds the trials whose execution could not be created, with the error
+    failed_trials                   jsonb NOT NULL DEFAULT '[]'::jsonb,
+
+    labels                          jsonb NOT NULL DEFAULT '{}'::jsonb,
+
+    -- auditing info
+    created_at                      timestamp NOT NULL DEFAULT (NOW() at time zone 'utc'),
+    modified_at                     timestamp,
+    finished_at                     timestamp
+);
+
+CREATE UNIQUE INDEX sweeps_uid_namespace_key ON sweeps (uid, namespace);
+
+-- +goose Down
+DROP TABLE sweeps;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE workspace_events
+(
+    id                  serial PRIMARY KEY,
+    workspace_id        integer NOT NULL REFERENCES workspaces ON DELETE CASCADE,
+    type                varchar(50) NOT NULL,
+
+    -- phase of the workspace after the event, and before it for phase changes
+    phase               varchar(50) NOT NULL,
+    previous_phase      varchar(50) NOT NULL DEFAULT '',
+    reason              text NOT NULL DEFAULT '',
+    -- parameters, template versions or other data that changed
+    details             jsonb NOT NULL DEFAULT '{}'::jsonb,
+
+    -- auditing info
+    actor               text NOT NULL DEFAULT '',
+    created_at          timestamp NOT NULL DEFAULT (NOW() at time zone 'utc')
+);
+
+CREATE INDEX workspace_events_workspace_id_idx ON workspace_events (workspace_id, created_at, id);
+
+-- +goose Down
+DROP TABLE workspace_events;
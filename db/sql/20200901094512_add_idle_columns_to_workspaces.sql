@@ -0,0 +1,9 @@
+-- +goose Up
+-- reason explains the current phase, such as why the workspace was paused automatically
+ALTER TABLE workspaces ADD COLUMN reason text NOT NULL DEFAULT '';
+-- last_activity_at is the time of the last heartbeat sent by the workspace's containers
+ALTER TABLE workspaces ADD COLUMN last_activity_at timestamp;
+
+-- +goose Down
+ALTER TABLE workspaces DROP COLUMN reason;
+ALTER TABLE workspaces DROP COLUMN last_activity_at;
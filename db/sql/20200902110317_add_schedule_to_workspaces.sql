@@ -0,0 +1,9 @@
+-- +goose Up
+-- schedule holds the cron expressions that resume and pause the workspace, and their timezone
+ALTER TABLE workspaces ADD COLUMN schedule jsonb;
+-- schedule_checked_at is the time up to which the schedule has been applied
+ALTER TABLE workspaces ADD COLUMN schedule_checked_at timestamp;
+
+-- +goose Down
+ALTER TABLE workspaces DROP COLUMN schedule;
+ALTER TABLE workspaces DROP COLUMN schedule_checked_at;
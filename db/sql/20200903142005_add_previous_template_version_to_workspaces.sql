@@ -0,0 +1,8 @@
+-- +goose Up
+-- previous_workspace_template_version and previous_parameters hold the state before an upgrade, until the upgraded workspace is running
+ALTER TABLE workspaces ADD COLUMN previous_workspace_template_version integer;
+ALTER TABLE workspaces ADD COLUMN previous_parameters jsonb;
+
+-- +goose Down
+ALTER TABLE workspaces DROP COLUMN previous_workspace_template_version;
+ALTER TABLE workspaces DROP COLUMN previous_parameters;
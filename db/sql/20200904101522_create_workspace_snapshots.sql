@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE workspace_snapshots
+(
+    id                  serial PRIMARY KEY,
+    uid                 varchar(30) NOT NULL CHECK(uid <> ''),
+    name                text NOT NULL CHECK(name <> ''),
+    namespace           varchar(30) NOT NULL,
+    workspace_id        integer NOT NULL REFERENCES workspaces ON DELETE CASCADE,
+
+    -- names of the VolumeSnapshots by the name of the workspace volume they are of
+    volume_snapshots    jsonb NOT NULL,
+    labels              jsonb NOT NULL DEFAULT '{}'::jsonb,
+
+    -- auditing info
+    created_at          timestamp NOT NULL DEFAULT (NOW() at time zone 'utc')
+);
+
+CREATE UNIQUE INDEX workspace_snapshots_uid_workspace_id_key ON workspace_snapshots (uid, workspace_id);
+
+-- +goose Down
+DROP TABLE workspace_snapshots;
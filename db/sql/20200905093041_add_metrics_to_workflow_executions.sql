@@ -0,0 +1,6 @@
+-- +goose Up
+-- metrics holds the final metrics of a finished workflow execution, so they are only read from its pods once
+ALTER TABLE workflow_executions ADD COLUMN metrics jsonb;
+
+-- +goose Down
+ALTER TABLE workflow_executions DROP COLUMN metrics;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- clone_resources is set while a cloned workspace has the snapshots or copy jobs its volumes were created from
+ALTER TABLE workspaces ADD COLUMN clone_resources boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE workspaces DROP COLUMN clone_resources;
@@ -17,7 +17,9 @@ import (
 	"net/http"
 	"path/filepath"
 	"strings"
+	"time"
 
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/gorilla/handlers"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
@@ -55,6 +57,7 @@ func main() {
 		if err != nil {
 			log.Fatalf("Failed to connect to Kubernetes cluster: %v", err)
 		}
+		v1.SetSystemKubernetes(client.Interface)
 
 		go watchConfigmapChanges(client, "onepanel", stopCh, func(configMap *corev1.ConfigMap) error {
 			log.Printf("Configmap changed")
@@ -88,10 +91,31 @@ func main() {
 				db.Close()
 			}
 
-			s := startRPCServer(v1.NewDB(db), kubeConfig, sysConfig, stopCh)
+			database := v1.NewDB(db)
+			s := startRPCServer(database, kubeConfig, sysConfig, stopCh)
+
+			reconcilerClient, err := v1.NewClient(kubeConfig, database, sysConfig)
+			if err != nil {
+				log.Fatalf("Failed to create workflow execution reconciler client: %v", err)
+			}
+			reconcilerStopCh := make(chan struct{})
+			go reconcileWorkflowExecutions(reconcilerClient, reconcilerStopCh)
+			// Store the final metrics of finished workflow executions, which are compared by CompareWorkflowExecutions
+			go runPeriodically(reconcilerClient, "workflow execution metrics", "WORKFLOW_EXECUTION_METRICS_RECONCILE_INTERVAL", 30*time.Second, reconcilerClient.ReconcileWorkflowExecutionMetrics, reconcilerStopCh)
+			// Launch the next executions of running sweeps
+			go runPeriodically(reconcilerClient, "sweeps", "SWEEP_RECONCILE_INTERVAL", 30*time.Second, reconcilerClient.ReconcileSweeps, reconcilerStopCh)
+			// Pause the workspaces that are idle according to their template's idle policy
+			go runPeriodically(reconcilerClient, "idle workspaces", "WORKSPACE_IDLE_RECONCILE_INTERVAL", time.Minute, reconcilerClient.ReconcileIdleWorkspaces, reconcilerStopCh)
+			// Resume and pause workspaces on their schedule
+			go runPeriodically(reconcilerClient, "workspace schedules", "WORKSPACE_SCHEDULE_RECONCILE_INTERVAL", 30*time.Second, reconcilerClient.ReconcileWorkspaceSchedules, reconcilerStopCh)
+			// Launch cloned workspaces once their volumes are copied
+			go runPeriodically(reconcilerClient, "workspace clones", "WORKSPACE_CLONE_RECONCILE_INTERVAL", 30*time.Second, reconcilerClient.ReconcileWorkspaceClones, reconcilerStopCh)
+			// Finish the resize of workspace volumes
+			go runPeriodically(reconcilerClient, "resizing workspaces", "WORKSPACE_RESIZE_RECONCILE_INTERVAL", 15*time.Second, reconcilerClient.ReconcileResizingWorkspaces, reconcilerStopCh)
 
 			<-stopCh
 
+			close(reconcilerStopCh)
 			s.Stop()
 			if err := db.Close(); err != nil {
 				log.Printf("[error] closing db connection")
@@ -147,6 +171,8 @@ func startRPCServer(db *v1.DB, kubeConfig *v1.Config, sysConfig v1.SystemConfig,
 	api.RegisterWorkspaceServiceServer(s, server.NewWorkspaceServer())
 	api.RegisterConfigServiceServer(s, server.NewConfigServer())
 	api.RegisterServiceServiceServer(s, server.NewServiceServer())
+	api.RegisterSweepServiceServer(s, server.NewSweepServer())
+	api.RegisterFileServiceServer(s, server.NewFileServer())
 
 	go func() {
 		if err := s.Serve(lis); err != nil {
@@ -182,6 +208,16 @@ func startHTTPProxy() {
 	registerHandler(api.RegisterWorkspaceServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
 	registerHandler(api.RegisterConfigServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
 	registerHandler(api.RegisterServiceServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
+	registerHandler(api.RegisterSweepServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
+	registerHandler(api.RegisterFileServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
+
+	// Artifact downloads are streamed by a plain HTTP handler instead of the grpc-gateway
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		log.Fatalf("Failed to dial RPC server: %v", err)
+	}
+	defer conn.Close()
+	handler := server.NewArtifactDownloadHandler(api.NewWorkflowServiceClient(conn), mux)
 
 	log.Printf("Starting HTTP proxy on port %v", *httpPort)
 
@@ -191,14 +227,14 @@ func startHTTPProxy() {
 	}
 
 	// Allow Content-Type for JSON
-	allowedHeaders := handlers.AllowedHeaders([]string{"Content-Type", "Authorization"})
+	allowedHeaders := handlers.AllowedHeaders([]string{"Content-Type", "Authorization", "Range"})
 
 	// Allow PUT. Have to include all others as it clears them out.
 	allowedMethods := handlers.AllowedMethods([]string{"HEAD", "GET", "POST", "PUT", "DELETE", "PATCH"})
 
 	if err := http.ListenAndServe(*httpPort, wsproxy.WebsocketProxy(
 		handlers.CORS(
-			handlers.AllowedOriginValidator(ogValidator), allowedHeaders, allowedMethods)(mux),
+			handlers.AllowedOriginValidator(ogValidator), allowedHeaders, allowedMethods)(handler),
 		wsproxy.WithTokenCookieName("auth-token"),
 	)); err != nil {
 		log.Fatalf("Failed to serve HTTP listener: %v", err)
@@ -262,6 +298,161 @@ func watchConfigmapChanges(client *v1.Client, namespace string, stopCh <-chan st
 	controller.Run(neverStopCh)
 }
 
+// reconcileWorkflowExecutions keeps the workflow_executions table in sync with Argo workflows until stopCh is closed.
+// Changes to Argo workflows are applied as they are observed. In addition, every WORKFLOW_EXECUTION_RECONCILE_INTERVAL
+// all unfinished executions are checked against the informer's store, which repairs anything missed. Only one replica at a time
+// runs the check, see v1.DB.TryExclusive. Executions whose workflow is deleted are marked as orphaned as soon as the deletion
+// is observed, or by the next check if it was missed.
+func reconcileWorkflowExecutions(client *v1.Client, stopCh <-chan struct{}) {
+	interval, err := time.ParseDuration(env.GetEnv("WORKFLOW_EXECUTION_RECONCILE_INTERVAL", "5m"))
+	if err != nil || interval <= 0 {
+		log.Errorf("Invalid WORKFLOW_EXECUTION_RECONCILE_INTERVAL, using 5m: %v", err)
+		interval = 5 * time.Minute
+	}
+
+	reconcile := func(obj interface{}, deleted bool) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		wf, ok := obj.(*wfv1.Workflow)
+		if !ok {
+			return
+		}
+
+		var repair *v1.WorkflowExecutionRepair
+		var err error
+		if deleted {
+			repair, err = client.ReconcileDeletedWorkflowExecution(wf)
+		} else {
+			repair, err = client.ReconcileWorkflowExecution(wf)
+		}
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": wf.Namespace,
+				"UID":       wf.Name,
+				"Error":     err.Error(),
+			}).Error("Unable to reconcile workflow execution.")
+			return
+		}
+		if repair != nil {
+			logWorkflowExecutionRepair(repair)
+		}
+	}
+	onChange := func(obj interface{}) {
+		reconcile(obj, false)
+	}
+
+	source := &cache.ListWatch{
+		ListFunc: func(options apiv1.ListOptions) (k8runtime.Object, error) {
+			return client.ArgoprojV1alpha1().Workflows("").List(options)
+		},
+		WatchFunc: func(options apiv1.ListOptions) (watch.Interface, error) {
+			return client.ArgoprojV1alpha1().Workflows("").Watch(options)
+		},
+	}
+	indexer, controller := cache.NewIndexerInformer(
+		source,
+		&wfv1.Workflow{},
+		0,
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: onChange,
+			UpdateFunc: func(old, new interface{}) {
+				oldWf, ok := old.(*wfv1.Workflow)
+				if !ok {
+					return
+				}
+				newWf, ok := new.(*wfv1.Workflow)
+				if !ok {
+					return
+				}
+				// Only the phase and times are reconciled, so ignore node progress updates
+				if oldWf.Status.Phase == newWf.Status.Phase &&
+					oldWf.Status.StartedAt.Equal(&newWf.Status.StartedAt) &&
+					oldWf.Status.FinishedAt.Equal(&newWf.Status.FinishedAt) {
+					return
+				}
+				onChange(newWf)
+			},
+			DeleteFunc: func(obj interface{}) {
+				reconcile(obj, true)
+			},
+		},
+		cache.Indexers{})
+	go controller.Run(stopCh)
+
+	if !cache.WaitForCacheSync(stopCh, controller.HasSynced) {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	reconcileAll := func() error {
+		report, err := client.ReconcileWorkflowExecutions(indexer)
+		if err != nil {
+			return err
+		}
+
+		for _, repair := range report.Repairs {
+			logWorkflowExecutionRepair(repair)
+		}
+		log.WithFields(log.Fields{
+			"Checked":  report.Checked,
+			"Repaired": len(report.Repairs),
+			"Orphaned": report.OrphanCount(),
+			"Errors":   report.Errors,
+		}).Info("Reconciled workflow executions.")
+
+		return nil
+	}
+	for {
+		if _, err := client.DB.TryExclusive("workflow executions", reconcileAll); err != nil {
+			log.Errorf("Failed to reconcile workflow executions: %v", err)
+		}
+
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runPeriodically runs fn every interval set by the envKey environment variable, or defaultInterval, until stopCh is closed.
+// Every replica of the API runs the loop, but only one at a time runs fn, see v1.DB.TryExclusive. name is used for the lock and logs.
+func runPeriodically(client *v1.Client, name, envKey string, defaultInterval time.Duration, fn func() error, stopCh <-chan struct{}) {
+	interval, err := time.ParseDuration(env.GetEnv(envKey, defaultInterval.String()))
+	if err != nil || interval <= 0 {
+		log.Errorf("Invalid %v, using %v: %v", envKey, defaultInterval, err)
+		interval = defaultInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			if _, err := client.DB.TryExclusive(name, fn); err != nil {
+				log.Errorf("Failed to reconcile %v: %v", name, err)
+			}
+		}
+	}
+}
+
+// logWorkflowExecutionRepair logs what the reconciler changed for a workflow execution
+func logWorkflowExecutionRepair(repair *v1.WorkflowExecutionRepair) {
+	log.WithFields(log.Fields{
+		"Namespace":     repair.Namespace,
+		"UID":           repair.UID,
+		"PreviousPhase": repair.Previous.Phase,
+		"Phase":         repair.Current.Phase,
+		"StartedAt":     repair.Current.StartedAt,
+		"FinishedAt":    repair.Current.FinishedAt,
+		"Orphaned":      repair.Orphaned,
+	}).Info("Repaired workflow execution.")
+}
+
 // customHeaderMatcher is used to allow certain headers so we don't require a grpc-gateway prefix
 func customHeaderMatcher(key string) (string, bool) {
 	lowerCaseKey := strings.ToLower(key)
@@ -0,0 +1,132 @@
+package v1
+
+import (
+	"bytes"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// GetArtifactStream opens the artifact at key in the named repository, or the default one if repository is empty.
+// It reads length bytes starting at offset. Only keys under the namespace's prefix can be read.
+// A length of 0 reads until the end. A negative offset reads the last -offset bytes.
+// The artifact is not read into memory, so the caller must close the returned Reader.
+func (c *Client) GetArtifactStream(namespace, repository, key string, offset, length int64) (artifact *ArtifactObject, err error) {
+	config, err := c.GetNamespaceConfig(namespace)
+	if err != nil {
+		return
+	}
+
+	provider, err := config.GetArtifactRepository(repository)
+	if err != nil {
+		return
+	}
+	if err = validateNamespaceArtifactKey(provider, namespace, key); err != nil {
+		return
+	}
+
+	storage, err := c.getStorage(namespace, provider)
+	if err != nil {
+		return
+	}
+
+	return getArtifactStream(storage, namespace, key, offset, length)
+}
+
+// getArtifactStream opens the artifact at key in storage, see GetArtifactStream
+func getArtifactStream(storage Storage, namespace, key string, offset, length int64) (artifact *ArtifactObject, err error) {
+	object, err := storage.Stat(key)
+	if err != nil {
+		if err == ErrStorageObjectNotFound {
+			return nil, util.NewUserError(codes.NotFound, "Artifact does not exist.")
+		}
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Key":       key,
+			"Error":     err.Error(),
+		}).Error("Unable to get artifact information.")
+		return nil, err
+	}
+
+	artifact = &ArtifactObject{
+		Key:         key,
+		Size:        object.Size,
+		ContentType: artifactContentType(key, object.ContentType),
+	}
+	artifact.Offset, artifact.Length, err = resolveArtifactRange(offset, length, object.Size)
+	if err != nil {
+		return nil, util.NewUserError(codes.OutOfRange, err.Error())
+	}
+
+	if artifact.Length == 0 {
+		artifact.Reader = ioutil.NopCloser(bytes.NewReader(nil))
+		return artifact, nil
+	}
+
+	artifact.Reader, err = storage.Get(key, artifact.Offset, artifact.Length)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Key":       key,
+			"Error":     err.Error(),
+		}).Error("Unable to read artifact.")
+		return nil, err
+	}
+
+	return artifact, nil
+}
+
+// PresignArtifactURL creates a short-lived URL to download (GET) or upload (PUT) the artifact at key in the named repository,
+// or the default one if repository is empty.
+// The URL expires after the namespace's PresignedURLExpiration. contentType is only used for uploads to GCS,
+// which must then send the same Content-Type header. Only keys under the namespace's prefix can be presigned.
+func (c *Client) PresignArtifactURL(namespace, repository, key, method, contentType string) (*ArtifactURL, error) {
+	if method != http.MethodGet && method != http.MethodPut {
+		return nil, util.NewUserError(codes.InvalidArgument, "Presigned URLs are only supported for GET and PUT.")
+	}
+	if err := validateArtifactKey(key); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	config, err := c.GetNamespaceConfig(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := config.GetArtifactRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateNamespaceArtifactKey(provider, namespace, key); err != nil {
+		return nil, err
+	}
+
+	storage, err := c.getStorage(namespace, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ArtifactURL{
+		Method:    method,
+		ExpiresAt: time.Now().Add(config.PresignedURLExpiration).UTC(),
+	}
+
+	result.URL, err = storage.Presign(key, method, contentType, result.ExpiresAt)
+	if err != nil {
+		if err == ErrStoragePresignNotSupported {
+			return nil, util.NewUserError(codes.FailedPrecondition, "The artifact repository does not support presigned URLs.")
+		}
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Key":       key,
+			"Method":    method,
+			"Error":     err.Error(),
+		}).Error("Unable to presign URL.")
+		return nil, err
+	}
+
+	return result, nil
+}
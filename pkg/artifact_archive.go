@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/env"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"io"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"strings"
+)
+
+// artifactArchiveMaxSize is the largest total size of the files in a directory download, such as 2Gi
+var artifactArchiveMaxSize = env.GetEnv("ARTIFACT_ARCHIVE_MAX_SIZE", "2Gi")
+
+// WriteArtifactArchive writes a zip or tar.gz archive of every file under prefix to w, which must be under the namespace's prefix.
+// Files are streamed one at a time, so the archive is never held in memory.
+// Nothing is written if the directory is empty or larger than ARTIFACT_ARCHIVE_MAX_SIZE, in which case an error is returned.
+func (c *Client) WriteArtifactArchive(namespace, prefix string, format ArtifactArchiveFormat, w io.Writer) error {
+	if format != ArtifactArchiveZip && format != ArtifactArchiveTarGz {
+		return util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Unknown archive format '%v'.", format))
+	}
+
+	maxSize, err := resource.ParseQuantity(artifactArchiveMaxSize)
+	if err != nil {
+		return err
+	}
+
+	prefix = strings.TrimPrefix(prefix, "/")
+	if prefix == "" {
+		return util.NewUserError(codes.InvalidArgument, "Directory is required.")
+	}
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	storage, provider, err := c.getFileStorage(namespace)
+	if err != nil {
+		return err
+	}
+	if err := validateNamespaceArtifactKey(provider, namespace, prefix); err != nil {
+		return err
+	}
+
+	objects, err := storage.List(prefix, true)
+	if err != nil {
+		return err
+	}
+	files := make([]*StorageObject, 0)
+	for _, object := range objects {
+		if !object.Directory {
+			files = append(files, object)
+		}
+	}
+	if len(files) == 0 {
+		return util.NewUserError(codes.NotFound, "Directory is empty or does not exist.")
+	}
+
+	totalSize := int64(0)
+	for _, file := range files {
+		totalSize += file.Size
+	}
+	if totalSize > maxSize.Value() {
+		return util.NewUserError(codes.FailedPrecondition, fmt.Sprintf("Directory is larger than the %v limit for downloads.", artifactArchiveMaxSize))
+	}
+
+	addFile := func(file *StorageObject, dst io.Writer) error {
+		src, err := storage.Get(file.Key, 0, 0)
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+
+		if _, err := io.Copy(dst, src); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Key":       file.Key,
+				"Error":     err.Error(),
+			}).Error("Unable to add file to archive.")
+			return err
+		}
+
+		return nil
+	}
+
+	if format == ArtifactArchiveZip {
+		zipWriter := zip.NewWriter(w)
+		for _, file := range files {
+			header := &zip.FileHeader{
+				Name:     artifactArchiveEntryName(prefix, file.Key),
+				Method:   zip.Deflate,
+				Modified: file.LastModified,
+			}
+			entry, err := zipWriter.CreateHeader(header)
+			if err != nil {
+				return err
+			}
+			if err := addFile(file, entry); err != nil {
+				return err
+			}
+		}
+
+		return zipWriter.Close()
+	}
+
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, file := range files {
+		header := &tar.Header{
+			Name:    artifactArchiveEntryName(prefix, file.Key),
+			Mode:    0644,
+			Size:    file.Size,
+			ModTime: file.LastModified,
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		if err := addFile(file, tarWriter); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+
+	return gzipWriter.Close()
+}
@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"bytes"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"k8s.io/client-go/kubernetes/fake"
+	"net/http"
+	"testing"
+)
+
+// TestClient_ArtifactNamespacePrefix makes sure artifacts of other namespaces can not be read, listed or presigned
+func TestClient_ArtifactNamespacePrefix(t *testing.T) {
+	c := &Client{Interface: fake.NewSimpleClientset(mockSystemConfigMap, mockSystemSecret)}
+	key := "artifacts/other/workflow/pod/main.log"
+
+	_, err := c.GetArtifactStream("onepanel", "", key, 0, 0)
+	assert.Equal(t, codes.PermissionDenied, err.(*util.UserError).Code)
+
+	for _, method := range []string{http.MethodGet, http.MethodPut} {
+		_, err = c.PresignArtifactURL("onepanel", "", key, method, "")
+		assert.Equal(t, codes.PermissionDenied, err.(*util.UserError).Code)
+	}
+
+	// Presigned URLs use the named repository
+	_, err = c.PresignArtifactURL("onepanel", "models", "artifacts/onepanel/workflow/pod/main.log", http.MethodGet, "")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).Code)
+
+	_, err = c.ListFiles("onepanel", "", "artifacts/other")
+	assert.Equal(t, codes.PermissionDenied, err.(*util.UserError).Code)
+
+	err = c.WriteArtifactArchive("onepanel", "artifacts/other/workflow", ArtifactArchiveZip, &bytes.Buffer{})
+	assert.Equal(t, codes.PermissionDenied, err.(*util.UserError).Code)
+}
@@ -0,0 +1,141 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/onepanelio/core/pkg/util"
+	"google.golang.org/grpc/codes"
+	"io"
+	"mime"
+	"path"
+	"strings"
+	"time"
+)
+
+// defaultArtifactContentType is used when the type of an artifact can not be determined
+const defaultArtifactContentType = "application/octet-stream"
+
+// ArtifactObject is an open artifact, or a byte range of one, along with its metadata.
+// The caller is responsible for closing Reader.
+type ArtifactObject struct {
+	Key         string
+	Size        int64 // Size of the whole artifact
+	Offset      int64 // Position of the first byte returned by Reader
+	Length      int64 // Number of bytes returned by Reader
+	ContentType string
+	Reader      io.ReadCloser
+}
+
+// ArtifactURL is a presigned URL that gives temporary access to an artifact without credentials
+type ArtifactURL struct {
+	URL       string
+	Method    string // GET to download, PUT to upload
+	ExpiresAt time.Time
+}
+
+// ArtifactArchiveFormat is the file format of a directory download
+type ArtifactArchiveFormat string
+
+const (
+	ArtifactArchiveZip   ArtifactArchiveFormat = "zip"
+	ArtifactArchiveTarGz ArtifactArchiveFormat = "tar.gz"
+)
+
+// ArtifactArchiveFormatFromString parses a format, an empty string results in zip
+func ArtifactArchiveFormatFromString(value string) (ArtifactArchiveFormat, error) {
+	switch ArtifactArchiveFormat(value) {
+	case "", ArtifactArchiveZip:
+		return ArtifactArchiveZip, nil
+	case ArtifactArchiveTarGz, "tgz":
+		return ArtifactArchiveTarGz, nil
+	}
+
+	return "", fmt.Errorf("unknown archive format '%v', expected zip or tar.gz", value)
+}
+
+// ContentType returns the MIME type of the archive format
+func (f ArtifactArchiveFormat) ContentType() string {
+	if f == ArtifactArchiveTarGz {
+		return "application/gzip"
+	}
+
+	return "application/zip"
+}
+
+// artifactArchiveEntryName returns the name of key inside the archive of prefix.
+// Entries are placed in a directory named after the last segment of prefix, so output/ extracts into output/.
+func artifactArchiveEntryName(prefix, key string) string {
+	prefix = strings.TrimSuffix(prefix, "/")
+
+	return FilePathToName(prefix) + "/" + strings.TrimPrefix(key, prefix+"/")
+}
+
+// validateArtifactKey makes sure key is the path of a file within the artifact repository
+func validateArtifactKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.HasSuffix(key, "/") {
+		return fmt.Errorf("key must be the path of a file")
+	}
+
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return fmt.Errorf("key can not contain empty, '.' or '..' segments")
+		}
+	}
+
+	return nil
+}
+
+// validateNamespaceArtifactKey returns a PermissionDenied error if key is not under the namespace's prefix in the repository,
+// so a namespace can not read or change the artifacts of other namespaces that share the bucket.
+// If the repository's key format does not contain the namespace, no key is allowed.
+func validateNamespaceArtifactKey(provider *ArtifactRepositoryProvider, namespace, key string) error {
+	prefix := provider.NamespacePrefix(namespace)
+	if prefix == "" {
+		return util.NewUserError(codes.FailedPrecondition, "The key format of the artifact repository does not start with a directory of the namespace, so its artifacts can not be accessed.")
+	}
+	if !strings.HasPrefix(key, prefix) {
+		return util.NewUserError(codes.PermissionDenied, fmt.Sprintf("Only keys under '%v' can be accessed.", prefix))
+	}
+
+	return nil
+}
+
+// resolveArtifactRange converts a requested offset and length into the range of an artifact of the given size.
+// A length of 0 reads until the end. A negative offset reads the last -offset bytes, and ignores length.
+func resolveArtifactRange(offset, length, size int64) (start, count int64, err error) {
+	if length < 0 {
+		return 0, 0, fmt.Errorf("length can not be negative")
+	}
+
+	if offset < 0 {
+		start = size + offset
+		if start < 0 {
+			start = 0
+		}
+		return start, size - start, nil
+	}
+
+	if offset > 0 && offset >= size {
+		return 0, 0, fmt.Errorf("offset %v is past the end of the artifact", offset)
+	}
+
+	count = size - offset
+	if length > 0 && length < count {
+		count = length
+	}
+
+	return offset, count, nil
+}
+
+// artifactContentType returns the stored content type of an artifact.
+// If nothing specific was stored, the type is guessed from the key's extension.
+func artifactContentType(key, stored string) string {
+	if stored != "" && stored != defaultArtifactContentType && stored != "binary/octet-stream" {
+		return stored
+	}
+
+	if guessed := mime.TypeByExtension(path.Ext(strings.TrimSuffix(key, "/"))); guessed != "" {
+		return guessed
+	}
+
+	return defaultArtifactContentType
+}
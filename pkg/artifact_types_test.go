@@ -0,0 +1,97 @@
+package v1
+
+import (
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"testing"
+)
+
+// Test_resolveArtifactRange makes sure requested ranges are clamped to the artifact
+func Test_resolveArtifactRange(t *testing.T) {
+	tests := []struct {
+		name           string
+		offset, length int64
+		start, count   int64
+		wantErr        bool
+	}{
+		{name: "whole artifact", offset: 0, length: 0, start: 0, count: 100},
+		{name: "from offset", offset: 10, length: 0, start: 10, count: 90},
+		{name: "offset and length", offset: 10, length: 20, start: 10, count: 20},
+		{name: "length past end", offset: 90, length: 20, start: 90, count: 10},
+		{name: "suffix", offset: -30, length: 0, start: 70, count: 30},
+		{name: "suffix larger than artifact", offset: -300, length: 0, start: 0, count: 100},
+		{name: "offset past end", offset: 100, length: 0, wantErr: true},
+		{name: "negative length", offset: 0, length: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		start, count, err := resolveArtifactRange(tt.offset, tt.length, 100)
+		if tt.wantErr {
+			assert.NotNil(t, err, tt.name)
+			continue
+		}
+		assert.Nil(t, err, tt.name)
+		assert.Equal(t, tt.start, start, tt.name)
+		assert.Equal(t, tt.count, count, tt.name)
+	}
+
+	start, count, err := resolveArtifactRange(0, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), start)
+	assert.Equal(t, int64(0), count)
+}
+
+// Test_artifactContentType makes sure generic stored types fall back to the extension
+func Test_artifactContentType(t *testing.T) {
+	assert.Equal(t, "text/csv", artifactContentType("output/results.csv", "text/csv"))
+	assert.Equal(t, "application/json", artifactContentType("output/metrics.json", "application/octet-stream"))
+	assert.Equal(t, defaultArtifactContentType, artifactContentType("output/model", ""))
+}
+
+// Test_validateArtifactKey makes sure upload keys can not escape or point to directories
+func Test_validateArtifactKey(t *testing.T) {
+	assert.Nil(t, validateArtifactKey("inputs/data.csv"))
+	assert.NotNil(t, validateArtifactKey(""))
+	assert.NotNil(t, validateArtifactKey("/inputs/data.csv"))
+	assert.NotNil(t, validateArtifactKey("inputs/"))
+	assert.NotNil(t, validateArtifactKey("inputs//data.csv"))
+	assert.NotNil(t, validateArtifactKey("inputs/../data.csv"))
+}
+
+// Test_validateNamespaceArtifactKey makes sure keys of other namespaces are rejected
+func Test_validateNamespaceArtifactKey(t *testing.T) {
+	provider := &ArtifactRepositoryProvider{
+		S3: &ArtifactRepositoryS3Provider{KeyFormat: "artifacts/{{workflow.namespace}}/{{workflow.name}}/{{pod.name}}"},
+	}
+	assert.Nil(t, validateNamespaceArtifactKey(provider, "onepanel", "artifacts/onepanel/inputs/data.csv"))
+	assert.NotNil(t, validateNamespaceArtifactKey(provider, "onepanel", "artifacts/other/inputs/data.csv"))
+	assert.NotNil(t, validateNamespaceArtifactKey(provider, "onepanel", "artifacts/onepanel-dev/data.csv"))
+	assert.NotNil(t, validateNamespaceArtifactKey(provider, "onepanel", "data.csv"))
+
+	// Without the namespace in the key format, no key belongs to the namespace
+	provider.S3.KeyFormat = "artifacts/{{workflow.name}}/{{pod.name}}"
+	err := validateNamespaceArtifactKey(provider, "onepanel", "artifacts/onepanel/inputs/data.csv")
+	assert.Equal(t, codes.FailedPrecondition, err.(*util.UserError).Code)
+}
+
+// TestArtifactArchiveFormatFromString makes sure zip is the default format
+func TestArtifactArchiveFormatFromString(t *testing.T) {
+	format, err := ArtifactArchiveFormatFromString("")
+	assert.Nil(t, err)
+	assert.Equal(t, ArtifactArchiveZip, format)
+
+	format, err = ArtifactArchiveFormatFromString("tgz")
+	assert.Nil(t, err)
+	assert.Equal(t, ArtifactArchiveTarGz, format)
+	assert.Equal(t, "application/gzip", format.ContentType())
+
+	_, err = ArtifactArchiveFormatFromString("rar")
+	assert.NotNil(t, err)
+}
+
+// Test_artifactArchiveEntryName makes sure entries are placed under the name of the downloaded directory
+func Test_artifactArchiveEntryName(t *testing.T) {
+	assert.Equal(t, "output/model.h5", artifactArchiveEntryName("artifacts/pod/output/", "artifacts/pod/output/model.h5"))
+	assert.Equal(t, "output/logs/train.log", artifactArchiveEntryName("artifacts/pod/output", "artifacts/pod/output/logs/train.log"))
+}
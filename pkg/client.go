@@ -1,33 +1,65 @@
 package v1
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	sq "github.com/Masterminds/squirrel"
 	argoprojv1alpha1 "github.com/argoproj/argo/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util/azure"
 	"github.com/onepanelio/core/pkg/util/gcs"
 	"github.com/onepanelio/core/pkg/util/router"
 	"github.com/onepanelio/core/pkg/util/s3"
 	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"strings"
 )
 
 type Config = rest.Config
 
 var sb = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
+// systemKubernetes is the Kubernetes client of the server itself, see SetSystemKubernetes
+var systemKubernetes kubernetes.Interface
+
+// SetSystemKubernetes sets the Kubernetes client of the server itself. It is used for the cluster-scoped resources the server
+// changes on behalf of users who can not change them, such as the PersistentVolumes of their workspaces.
+func SetSystemKubernetes(client kubernetes.Interface) {
+	systemKubernetes = client
+}
+
 type Client struct {
 	kubernetes.Interface
 	argoprojV1alpha1 argoprojv1alpha1.ArgoprojV1alpha1Interface
+	// dynamic is used for resources without a typed client, such as VolumeSnapshots
+	dynamic dynamic.Interface
 	*DB
 	systemConfig SystemConfig
+	// actor identifies who the client acts for, it is recorded in the history of the resources it changes
+	actor string
 }
 
 func (c *Client) ArgoprojV1alpha1() argoprojv1alpha1.ArgoprojV1alpha1Interface {
 	return c.argoprojV1alpha1
 }
 
+func (c *Client) Dynamic() dynamic.Interface {
+	return c.dynamic
+}
+
+// SystemKubernetes returns the Kubernetes client of the server, or the client's own if it is not set, such as in tests.
+// Only use it for changes the caller has been authorized for.
+func (c *Client) SystemKubernetes() kubernetes.Interface {
+	if systemKubernetes == nil {
+		return c.Interface
+	}
+
+	return systemKubernetes
+}
+
 func NewConfig() (config *Config) {
 	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
 		clientcmd.NewDefaultClientConfigLoadingRules(), &clientcmd.ConfigOverrides{}).ClientConfig()
@@ -59,14 +91,54 @@ func NewClient(config *Config, db *DB, systemConfig SystemConfig) (client *Clien
 		return
 	}
 
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return
+	}
+
 	return &Client{
 		Interface:        kubeClient,
 		argoprojV1alpha1: argoClient,
+		dynamic:          dynamicClient,
 		DB:               db,
 		systemConfig:     systemConfig,
+		actor:            bearerTokenSubject(config.BearerToken),
 	}, nil
 }
 
+// Actor returns who the client acts for, such as system:serviceaccount:<namespace>:<name>. It is empty if unknown.
+func (c *Client) Actor() string {
+	return c.actor
+}
+
+// bearerTokenSubject returns the email, or otherwise the subject, of a JWT bearer token such as a service account token.
+// The signature is not verified here, the token is verified by Kubernetes when the client makes a request.
+func bearerTokenSubject(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return ""
+	}
+
+	claims := struct {
+		Email   string `json:"email"`
+		Subject string `json:"sub"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+
+	if claims.Email != "" {
+		return claims.Email
+	}
+
+	return claims.Subject
+}
+
 // GetS3Client initializes a client to Amazon Cloud Storage.
 func (c *Client) GetS3Client(namespace string, config *ArtifactRepositoryS3Provider) (s3Client *s3.Client, err error) {
 	s3Client, err = s3.NewClient(s3.Config{
@@ -92,6 +164,24 @@ func (c *Client) GetGCSClient(namespace string, config *ArtifactRepositoryGCSPro
 	return gcs.NewClient(namespace, config.ServiceAccountJSON)
 }
 
+// GetAzureClient initializes a client to Azure Blob Storage.
+func (c *Client) GetAzureClient(namespace string, config *ArtifactRepositoryAzureProvider) (azureClient *azure.Client, err error) {
+	azureClient, err = azure.NewClient(azure.Config{
+		Account:    config.Account,
+		AccountKey: config.AccountKey,
+		Endpoint:   config.Endpoint,
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Account":   config.Account,
+			"Error":     err.Error(),
+		}).Error("GetAzureClient failed when initializing a new Azure client.")
+		return
+	}
+	return
+}
+
 // GetWebRouter creates a new web router using the system configuration
 func (c *Client) GetWebRouter() (router.Web, error) {
 	sysConfig, err := c.GetSystemConfig()
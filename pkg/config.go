@@ -76,10 +76,48 @@ func (c *Client) GetNamespaceConfig(namespace string) (config *NamespaceConfig,
 	}
 
 	err = yaml.Unmarshal([]byte(configMap.Data["artifactRepository"]), &config.ArtifactRepository)
-	if err != nil || (config.ArtifactRepository.S3 == nil && config.ArtifactRepository.GCS == nil) {
+	if err != nil || !config.ArtifactRepository.IsConfigured() {
 		return nil, util.NewUserError(codes.NotFound, "Artifact repository config not found.")
 	}
 
+	config.PresignedURLExpiration, err = parsePresignedURLExpiration(configMap.Data["presignedURLExpiration"])
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Error":     err.Error(),
+		}).Warn("getNamespaceConfig found an invalid presignedURLExpiration, using the default.")
+	}
+
+	if data, ok := configMap.Data["artifactRepositories"]; ok {
+		repositories := make(map[string]*ArtifactRepositoryProvider)
+		if err := yaml.Unmarshal([]byte(data), &repositories); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Error":     err.Error(),
+			}).Warn("getNamespaceConfig found invalid artifactRepositories, ignoring them.")
+		}
+		config.ArtifactRepositories = make(map[string]*ArtifactRepositoryProvider)
+		for name, repository := range repositories {
+			if repository == nil || !repository.IsConfigured() {
+				log.WithFields(log.Fields{
+					"Namespace":  namespace,
+					"Repository": name,
+				}).Warn("getNamespaceConfig found an artifact repository without a provider, ignoring it.")
+				continue
+			}
+			config.ArtifactRepositories[name] = repository
+		}
+	}
+
+	// The filesystem provider has no credentials
+	needsSecret := config.ArtifactRepository.FileSystem == nil
+	for _, repository := range config.ArtifactRepositories {
+		needsSecret = needsSecret || repository.FileSystem == nil
+	}
+	if !needsSecret {
+		return config, nil
+	}
+
 	secret, err := c.GetSecret(namespace, "onepanel")
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -89,22 +127,33 @@ func (c *Client) GetNamespaceConfig(namespace string) (config *NamespaceConfig,
 		return
 	}
 
+	decodeArtifactRepositoryCredentials(&config.ArtifactRepository, secret)
+	for _, repository := range config.ArtifactRepositories {
+		decodeArtifactRepositoryCredentials(repository, secret)
+	}
+
+	return config, nil
+}
+
+// decodeArtifactRepositoryCredentials sets the credentials of the provider from the onepanel secret
+func decodeArtifactRepositoryCredentials(provider *ArtifactRepositoryProvider, secret *Secret) {
 	switch {
-	case config.ArtifactRepository.S3 != nil:
+	case provider.S3 != nil:
 		{
-			accessKey, _ := base64.StdEncoding.DecodeString(secret.Data[config.ArtifactRepository.S3.AccessKeySecret.Key])
-			config.ArtifactRepository.S3.AccessKey = string(accessKey)
-			secretKey, _ := base64.StdEncoding.DecodeString(secret.Data[config.ArtifactRepository.S3.SecretKeySecret.Key])
-			config.ArtifactRepository.S3.Secretkey = string(secretKey)
+			accessKey, _ := base64.StdEncoding.DecodeString(secret.Data[provider.S3.AccessKeySecret.Key])
+			provider.S3.AccessKey = string(accessKey)
+			secretKey, _ := base64.StdEncoding.DecodeString(secret.Data[provider.S3.SecretKeySecret.Key])
+			provider.S3.Secretkey = string(secretKey)
 		}
-	case config.ArtifactRepository.GCS != nil:
+	case provider.GCS != nil:
 		{
-			serviceJSON, _ := base64.StdEncoding.DecodeString(secret.Data[config.ArtifactRepository.GCS.ServiceAccountKeySecret.Key])
-			config.ArtifactRepository.GCS.ServiceAccountJSON = string(serviceJSON)
+			serviceJSON, _ := base64.StdEncoding.DecodeString(secret.Data[provider.GCS.ServiceAccountKeySecret.Key])
+			provider.GCS.ServiceAccountJSON = string(serviceJSON)
+		}
+	case provider.Azure != nil:
+		{
+			accountKey, _ := base64.StdEncoding.DecodeString(secret.Data[provider.Azure.AccountKeySecret.Key])
+			provider.Azure.AccountKey = string(accountKey)
 		}
-	default:
-		return nil, util.NewUserError(codes.NotFound, "Artifact repository config not found.")
 	}
-
-	return
 }
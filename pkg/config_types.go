@@ -4,9 +4,12 @@ import (
 	"encoding/base64"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/onepanelio/core/pkg/util"
 	"github.com/onepanelio/core/pkg/util/ptr"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
 	"gopkg.in/yaml.v3"
 	corev1 "k8s.io/api/core/v1"
 	k8yaml "sigs.k8s.io/yaml"
@@ -123,6 +126,27 @@ func (s SystemConfig) NodePoolOptionByValue(value string) (option *NodePoolOptio
 	return
 }
 
+// NamespaceQuota loads and parses the quota of the namespace from the namespaceQuotas config.
+// namespaceQuotas maps namespaces to their quota, namespaces without one use the "default" quota.
+// If there is no quota for the namespace, nil is returned.
+func (s SystemConfig) NamespaceQuota(namespace string) (quota *NamespaceQuota, err error) {
+	data := s.GetValue("namespaceQuotas")
+	if data == nil {
+		return nil, nil
+	}
+
+	quotas := make(map[string]*NamespaceQuota)
+	if err = k8yaml.Unmarshal([]byte(*data), &quotas); err != nil {
+		return
+	}
+
+	if quota, ok := quotas[namespace]; ok {
+		return quota, nil
+	}
+
+	return quotas["default"], nil
+}
+
 // DatabaseDriverName gets the databaseDriverName value, or nil.
 func (s SystemConfig) DatabaseDriverName() *string {
 	return s.GetValue("databaseDriverName")
@@ -211,14 +235,39 @@ type ArtifactRepositoryGCSProvider struct {
 	ServiceAccountJSON      string                   `yaml:"serviceAccountJSON,omitempty"`
 }
 
-// ArtifactRepositoryProvider is used to setup access into AWS Cloud Storage
-// or Google Cloud storage.
-// - The relevant sub-struct (S3, GCS) is unmarshalled into from the cluster configmap.
-// Right now, either the S3 or GCS struct will be filled in. Multiple cloud
+// ArtifactRepositoryAzureProvider is meant to be used
+// by the CLI. CLI will marshal this struct into the correct
+// YAML structure for k8s configmap / secret.
+// Endpoint is optional, set it to use an emulator such as Azurite.
+// Argo can not write workflow artifacts to Azure, so this provider is used to read and manage files.
+type ArtifactRepositoryAzureProvider struct {
+	KeyFormat        string `yaml:"keyFormat"`
+	Account          string
+	Container        string
+	Endpoint         string                   `yaml:"endpoint,omitempty"`
+	AccountKeySecret ArtifactRepositorySecret `yaml:"accountKeySecret"`
+	AccountKey       string                   `yaml:"accountKey,omitempty"`
+}
+
+// ArtifactRepositoryFileSystemProvider stores artifacts in a directory of the local filesystem, such as a mounted volume.
+// It is meant for development and tests, Argo can not write workflow artifacts to it.
+// The server must enable it with ARTIFACT_FILESYSTEM_ROOT, each namespace stores its files in a sub directory of it.
+type ArtifactRepositoryFileSystemProvider struct {
+	KeyFormat string `yaml:"keyFormat"`
+	// Path is an optional directory inside the namespace's directory
+	Path string
+}
+
+// ArtifactRepositoryProvider is used to setup access into AWS Cloud Storage,
+// Google Cloud storage, Azure Blob Storage or the local filesystem.
+// - The relevant sub-struct (S3, GCS, Azure, FileSystem) is unmarshalled into from the cluster configmap.
+// Right now, only one of the structs will be filled in. Multiple cloud
 // providers are not supported at the same time in params.yaml (manifests deployment).
 type ArtifactRepositoryProvider struct {
-	S3  *ArtifactRepositoryS3Provider  `yaml:"s3,omitempty"`
-	GCS *ArtifactRepositoryGCSProvider `yaml:"gcs,omitempty"`
+	S3         *ArtifactRepositoryS3Provider         `yaml:"s3,omitempty"`
+	GCS        *ArtifactRepositoryGCSProvider        `yaml:"gcs,omitempty"`
+	Azure      *ArtifactRepositoryAzureProvider      `yaml:"azure,omitempty"`
+	FileSystem *ArtifactRepositoryFileSystemProvider `yaml:"filesystem,omitempty"`
 }
 
 // ArtifactRepositorySecret holds information about a kubernetes Secret.
@@ -289,13 +338,38 @@ func (g *ArtifactRepositoryGCSProvider) MarshalToYaml() (string, error) {
 	return builder.String(), nil
 }
 
-// FormatKey replaces placeholder values with their actual values and returns this string.
+// MarshalToYaml is used by the CLI to generate configmaps during deployment
+// or build operations.
+func (a *ArtifactRepositoryAzureProvider) MarshalToYaml() (string, error) {
+	builder := &strings.Builder{}
+	encoder := yaml.NewEncoder(builder)
+	encoder.SetIndent(6)
+	defer encoder.Close()
+	err := encoder.Encode(&ArtifactRepositoryProvider{
+		Azure: &ArtifactRepositoryAzureProvider{
+			KeyFormat: a.KeyFormat,
+			Account:   a.Account,
+			Container: a.Container,
+			Endpoint:  a.Endpoint,
+			AccountKeySecret: ArtifactRepositorySecret{
+				Name: a.AccountKeySecret.Name,
+				Key:  a.AccountKeySecret.Key,
+			},
+		},
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return builder.String(), nil
+}
+
+// formatArtifactKey replaces the placeholder values of keyFormat with their actual values and returns this string.
 // {{workflow.namespace}} -> namespace
 // {{workflow.name}} -> workflowName
 // {{pod.name}} -> podName
-func (a *ArtifactRepositoryS3Provider) FormatKey(namespace, workflowName, podName string) string {
-	keyFormat := a.KeyFormat
-
+func formatArtifactKey(keyFormat, namespace, workflowName, podName string) string {
 	keyFormat = strings.Replace(keyFormat, "{{workflow.namespace}}", namespace, -1)
 	keyFormat = strings.Replace(keyFormat, "{{workflow.name}}", workflowName, -1)
 	keyFormat = strings.Replace(keyFormat, "{{pod.name}}", podName, -1)
@@ -303,20 +377,122 @@ func (a *ArtifactRepositoryS3Provider) FormatKey(namespace, workflowName, podNam
 	return keyFormat
 }
 
-// FormatKey replaces placeholder values with their actual values and returns this string.
-// {{workflow.namespace}} -> namespace
-// {{workflow.name}} -> workflowName
-// {{pod.name}} -> podName
+// FormatKey replaces placeholder values with their actual values and returns this string, see formatArtifactKey
+func (a *ArtifactRepositoryS3Provider) FormatKey(namespace, workflowName, podName string) string {
+	return formatArtifactKey(a.KeyFormat, namespace, workflowName, podName)
+}
+
+// FormatKey replaces placeholder values with their actual values and returns this string, see formatArtifactKey
 func (g *ArtifactRepositoryGCSProvider) FormatKey(namespace, workflowName, podName string) string {
-	keyFormat := g.KeyFormat
+	return formatArtifactKey(g.KeyFormat, namespace, workflowName, podName)
+}
 
-	keyFormat = strings.Replace(keyFormat, "{{workflow.namespace}}", namespace, -1)
-	keyFormat = strings.Replace(keyFormat, "{{workflow.name}}", workflowName, -1)
-	keyFormat = strings.Replace(keyFormat, "{{pod.name}}", podName, -1)
+// FormatKey replaces placeholder values with their actual values and returns this string, see formatArtifactKey
+func (a *ArtifactRepositoryAzureProvider) FormatKey(namespace, workflowName, podName string) string {
+	return formatArtifactKey(a.KeyFormat, namespace, workflowName, podName)
+}
 
-	return keyFormat
+// FormatKey replaces placeholder values with their actual values and returns this string, see formatArtifactKey
+func (a *ArtifactRepositoryFileSystemProvider) FormatKey(namespace, workflowName, podName string) string {
+	return formatArtifactKey(a.KeyFormat, namespace, workflowName, podName)
 }
 
+// IsConfigured returns true if one of the providers is set
+func (p *ArtifactRepositoryProvider) IsConfigured() bool {
+	return p.S3 != nil || p.GCS != nil || p.Azure != nil || p.FileSystem != nil
+}
+
+// SupportsWorkflowArtifacts returns true if Argo can read and write workflow artifacts in the repository.
+// Azure and filesystem repositories are read-only for workflows, their files are only managed through the API.
+func (p *ArtifactRepositoryProvider) SupportsWorkflowArtifacts() bool {
+	return p.S3 != nil || p.GCS != nil
+}
+
+// FormatKey formats the key of the configured provider, see ArtifactRepositoryS3Provider.FormatKey
+func (p *ArtifactRepositoryProvider) FormatKey(namespace, workflowName, podName string) string {
+	switch {
+	case p.S3 != nil:
+		return p.S3.FormatKey(namespace, workflowName, podName)
+	case p.GCS != nil:
+		return p.GCS.FormatKey(namespace, workflowName, podName)
+	case p.Azure != nil:
+		return p.Azure.FormatKey(namespace, workflowName, podName)
+	case p.FileSystem != nil:
+		return p.FileSystem.FormatKey(namespace, workflowName, podName)
+	}
+
+	return ""
+}
+
+// NamespacePrefix returns the part of the formatted key that is the same for all artifacts of the namespace,
+// up to the last "/" before the first workflow or pod placeholder. For the default key format it is artifacts/<namespace>/
+// It is empty if that part does not contain the namespace, then the keys of namespaces can not be told apart.
+func (p *ArtifactRepositoryProvider) NamespacePrefix(namespace string) string {
+	keyFormat := p.FormatKey("{{workflow.namespace}}", "{{workflow.name}}", "{{pod.name}}")
+	namespaceIndex := strings.Index(keyFormat, "{{workflow.namespace}}")
+	if namespaceIndex < 0 || strings.Index(keyFormat, "{{") < namespaceIndex {
+		return ""
+	}
+
+	key := p.FormatKey(namespace, "{{workflow.name}}", "{{pod.name}}")
+	if i := strings.Index(key, "{{"); i >= 0 {
+		key = key[:i]
+	}
+	prefix := key[:strings.LastIndex(key, "/")+1]
+	if len(prefix) <= namespaceIndex+len(namespace) {
+		return ""
+	}
+
+	return prefix
+}
+
+const (
+	// defaultPresignedURLExpiration is how long presigned artifact URLs are valid if the namespace does not configure it
+	defaultPresignedURLExpiration = 15 * time.Minute
+	// maxPresignedURLExpiration is the longest expiration S3 and GCS allow for presigned URLs
+	maxPresignedURLExpiration = 7 * 24 * time.Hour
+)
+
 type NamespaceConfig struct {
+	// ArtifactRepository is the default repository, set by the artifactRepository key of the configmap
 	ArtifactRepository ArtifactRepositoryProvider
+	// ArtifactRepositories are additional repositories by name, set by the artifactRepositories key of the configmap
+	ArtifactRepositories map[string]*ArtifactRepositoryProvider
+	// PresignedURLExpiration is how long presigned artifact URLs are valid, set by the presignedURLExpiration key of the configmap
+	PresignedURLExpiration time.Duration
+}
+
+// GetArtifactRepository returns the artifact repository with the name, or the default repository if name is empty
+func (n *NamespaceConfig) GetArtifactRepository(name string) (*ArtifactRepositoryProvider, error) {
+	if name == "" {
+		return &n.ArtifactRepository, nil
+	}
+
+	repository, ok := n.ArtifactRepositories[name]
+	if !ok {
+		return nil, util.NewUserError(codes.NotFound, fmt.Sprintf("Artifact repository '%v' not found.", name))
+	}
+
+	return repository, nil
+}
+
+// parsePresignedURLExpiration parses a duration such as "30m". Empty or invalid values result in defaultPresignedURLExpiration,
+// and values over maxPresignedURLExpiration are capped.
+func parsePresignedURLExpiration(value string) (time.Duration, error) {
+	if value == "" {
+		return defaultPresignedURLExpiration, nil
+	}
+
+	expiration, err := time.ParseDuration(value)
+	if err != nil {
+		return defaultPresignedURLExpiration, err
+	}
+	if expiration <= 0 {
+		return defaultPresignedURLExpiration, fmt.Errorf("presignedURLExpiration must be positive")
+	}
+	if expiration > maxPresignedURLExpiration {
+		return maxPresignedURLExpiration, nil
+	}
+
+	return expiration, nil
 }
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+	"testing"
+	"time"
+)
+
+// Test_parsePresignedURLExpiration makes sure invalid values fall back to the default and long values are capped
+func Test_parsePresignedURLExpiration(t *testing.T) {
+	expiration, err := parsePresignedURLExpiration("")
+	assert.Nil(t, err)
+	assert.Equal(t, defaultPresignedURLExpiration, expiration)
+
+	expiration, err = parsePresignedURLExpiration("30m")
+	assert.Nil(t, err)
+	assert.Equal(t, 30*time.Minute, expiration)
+
+	expiration, err = parsePresignedURLExpiration("720h")
+	assert.Nil(t, err)
+	assert.Equal(t, maxPresignedURLExpiration, expiration)
+
+	expiration, err = parsePresignedURLExpiration("soon")
+	assert.NotNil(t, err)
+	assert.Equal(t, defaultPresignedURLExpiration, expiration)
+
+	expiration, err = parsePresignedURLExpiration("-1m")
+	assert.NotNil(t, err)
+	assert.Equal(t, defaultPresignedURLExpiration, expiration)
+}
+
+// TestArtifactRepositoryProvider_Azure makes sure the azure provider is parsed from the configmap and formats keys
+func TestArtifactRepositoryProvider_Azure(t *testing.T) {
+	config := `
+azure:
+  keyFormat: artifacts/{{workflow.namespace}}/{{workflow.name}}/{{pod.name}}
+  account: devstoreaccount1
+  container: onepanel
+  endpoint: http://127.0.0.1:10000/devstoreaccount1
+  accountKeySecret:
+    name: onepanel
+    key: artifactRepositoryAzureAccountKey
+`
+	provider := &ArtifactRepositoryProvider{}
+	err := yaml.Unmarshal([]byte(config), provider)
+	assert.Nil(t, err)
+	assert.Nil(t, provider.S3)
+	assert.NotNil(t, provider.Azure)
+	assert.Equal(t, "onepanel", provider.Azure.Container)
+	assert.Equal(t, "artifactRepositoryAzureAccountKey", provider.Azure.AccountKeySecret.Key)
+	assert.Equal(t, "artifacts/onepanel/train/train-123", provider.Azure.FormatKey("onepanel", "train", "train-123"))
+}
+
+// TestArtifactRepositoryProvider_NamespacePrefix makes sure the prefix stops before the first workflow or pod placeholder
+func TestArtifactRepositoryProvider_NamespacePrefix(t *testing.T) {
+	provider := &ArtifactRepositoryProvider{
+		S3: &ArtifactRepositoryS3Provider{KeyFormat: "artifacts/{{workflow.namespace}}/{{workflow.name}}/{{pod.name}}"},
+	}
+	assert.Equal(t, "artifacts/onepanel/", provider.NamespacePrefix("onepanel"))
+
+	provider.S3.KeyFormat = "{{workflow.namespace}}/runs-{{workflow.name}}/{{pod.name}}"
+	assert.Equal(t, "onepanel/", provider.NamespacePrefix("onepanel"))
+
+	provider.S3.KeyFormat = "{{workflow.name}}/{{pod.name}}"
+	assert.Equal(t, "", provider.NamespacePrefix("onepanel"))
+
+	// The namespace must come before the other placeholders and end a directory
+	provider.S3.KeyFormat = "artifacts/{{workflow.name}}/{{workflow.namespace}}/{{pod.name}}"
+	assert.Equal(t, "", provider.NamespacePrefix("onepanel"))
+
+	provider.S3.KeyFormat = "artifacts/{{workflow.namespace}}-{{workflow.name}}/{{pod.name}}"
+	assert.Equal(t, "", provider.NamespacePrefix("onepanel"))
+}
+
+// TestNamespaceConfig_GetArtifactRepository makes sure named repositories are found and an empty name is the default
+func TestNamespaceConfig_GetArtifactRepository(t *testing.T) {
+	config := &NamespaceConfig{
+		ArtifactRepository: ArtifactRepositoryProvider{
+			GCS: &ArtifactRepositoryGCSProvider{Bucket: "raw-data"},
+		},
+		ArtifactRepositories: map[string]*ArtifactRepositoryProvider{
+			"models": {
+				S3: &ArtifactRepositoryS3Provider{Bucket: "models"},
+			},
+		},
+	}
+
+	repository, err := config.GetArtifactRepository("")
+	assert.Nil(t, err)
+	assert.Equal(t, "raw-data", repository.GCS.Bucket)
+
+	repository, err = config.GetArtifactRepository("models")
+	assert.Nil(t, err)
+	assert.Equal(t, "models", repository.S3.Bucket)
+
+	_, err = config.GetArtifactRepository("missing")
+	assert.NotNil(t, err)
+}
+
+// TestSystemConfig_NamespaceQuota makes sure namespaces without a quota use the default one
+func TestSystemConfig_NamespaceQuota(t *testing.T) {
+	config := SystemConfig{}
+	quota, err := config.NamespaceQuota("onepanel")
+	assert.Nil(t, err)
+	assert.Nil(t, quota)
+
+	config["namespaceQuotas"] = `
+default:
+  workspaces: 5
+  nodePools:
+    Standard_NC6: 2
+vision:
+  workspaces: 10
+  workflowExecutions: 20
+`
+	quota, err = config.NamespaceQuota("onepanel")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), quota.Workspaces)
+	assert.Equal(t, int64(2), quota.NodePools["Standard_NC6"])
+
+	quota, err = config.NamespaceQuota("vision")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), quota.Workspaces)
+	assert.Equal(t, int64(20), quota.WorkflowExecutions)
+	assert.Len(t, quota.NodePools, 0)
+}
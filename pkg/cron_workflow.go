@@ -311,8 +311,8 @@ func (c *Client) DeleteCronWorkflowLabel(namespace, name string, keysToDelete ..
 // ListCronWorkflows selects all of the cron workflows for the given namespace and workflow template uid
 func (c *Client) ListCronWorkflows(namespace, workflowTemplateUID string, pagination *pagination.PaginationRequest) (cronWorkflows []*CronWorkflow, err error) {
 	sb := c.cronWorkflowSelectBuilder(namespace, workflowTemplateUID).
-		OrderBy("cw.created_at DESC")
-	sb = *pagination.ApplyToSelect(&sb)
+		OrderBy("cw.created_at DESC", "cw.id DESC")
+	sb = *pagination.ApplyToSelect(&sb, "cw.created_at", "cw.id")
 
 	if err := c.DB.Selectx(&cronWorkflows, sb); err != nil {
 		return nil, err
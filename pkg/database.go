@@ -5,6 +5,13 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
+const (
+	// advisoryLockReconciler is the class of the advisory locks that let one replica at a time run a background reconciler
+	advisoryLockReconciler = 1
+	// advisoryLockQuota is the class of the advisory locks that serialize the quota checks of a namespace
+	advisoryLockQuota = 2
+)
+
 // DB represents a database connection. It wraps a sqlx.DB to provide convenience methods.
 type DB struct {
 	sqlx.DB
@@ -40,3 +47,44 @@ func (db *DB) Getx(dest interface{}, builder sq.SelectBuilder) error {
 
 	return db.Get(dest, query, args...)
 }
+
+// TryExclusive runs fn unless another connection, for example of another replica, is running fn with the same name.
+// The advisory lock is held by a transaction, so it is released when fn returns or the connection is lost.
+// ran is false if fn did not run.
+func (db *DB) TryExclusive(name string, fn func() error) (ran bool, err error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	if err := tx.QueryRow("SELECT pg_try_advisory_xact_lock($1, hashtext($2))", advisoryLockReconciler, name).Scan(&ran); err != nil || !ran {
+		return false, err
+	}
+
+	if err := fn(); err != nil {
+		return true, err
+	}
+
+	return true, tx.Commit()
+}
+
+// Exclusive runs fn once no other connection, for example of another replica, is running fn with the same class and name.
+// The advisory lock is held by a transaction, so it is released when fn returns or the connection is lost.
+func (db *DB) Exclusive(class int, name string, fn func() error) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("SELECT pg_advisory_xact_lock($1, hashtext($2))", class, name); err != nil {
+		return err
+	}
+
+	if err := fn(); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
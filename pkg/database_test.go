@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// TestDB_TryExclusive makes sure a function does not run while another connection runs one with the same name
+func TestDB_TryExclusive(t *testing.T) {
+	c := DefaultTestClient()
+
+	ran, err := c.DB.TryExclusive("sweeps", func() error {
+		nestedRan, err := c.DB.TryExclusive("sweeps", func() error {
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.False(t, nestedRan)
+
+		nestedRan, err = c.DB.TryExclusive("workspace schedules", func() error {
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.True(t, nestedRan)
+
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.True(t, ran)
+
+	// The lock is released when the function fails
+	ran, err = c.DB.TryExclusive("sweeps", func() error {
+		return fmt.Errorf("failed")
+	})
+	assert.NotNil(t, err)
+	assert.True(t, ran)
+
+	ran, err = c.DB.TryExclusive("sweeps", func() error {
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.True(t, ran)
+}
@@ -0,0 +1,272 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"strings"
+	"time"
+)
+
+// resolveFilePath returns the key of the file at path, or the keys of everything in the directory at path.
+// For directories, prefix is path with a trailing slash.
+func resolveFilePath(storage Storage, path string) (keys []string, prefix string, directory bool, err error) {
+	path = strings.TrimPrefix(path, "/")
+	if path == "" {
+		return nil, "", false, util.NewUserError(codes.InvalidArgument, "Path is required.")
+	}
+
+	if !strings.HasSuffix(path, "/") {
+		exists, err := storageObjectExists(storage, path)
+		if err != nil {
+			return nil, "", false, err
+		}
+		if exists {
+			return []string{path}, path, false, nil
+		}
+		path += "/"
+	}
+
+	keys, err = listStorageKeys(storage, path)
+	if err != nil {
+		return nil, "", false, err
+	}
+	if len(keys) == 0 {
+		return nil, "", false, util.NewUserError(codes.NotFound, "File or directory does not exist.")
+	}
+
+	return keys, path, true, nil
+}
+
+// getFileStorage returns the storage of the namespace's default artifact repository, and the repository
+func (c *Client) getFileStorage(namespace string) (Storage, *ArtifactRepositoryProvider, error) {
+	config, err := c.GetNamespaceConfig(namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage, err := c.getStorage(namespace, &config.ArtifactRepository)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return storage, &config.ArtifactRepository, nil
+}
+
+// validateFilePath returns a PermissionDenied error if the file or directory at path is not under the namespace's prefix.
+// An empty path is left to resolveFilePath and validateArtifactKey to report.
+func validateFilePath(provider *ArtifactRepositoryProvider, namespace, path string) error {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+
+	return validateNamespaceArtifactKey(provider, namespace, path+"/")
+}
+
+// DeleteFiles deletes the file at path and returns the keys of the objects deleted.
+// Directories are only deleted if recursive is set, and since that deletes everything in them, confirm must be set too.
+// If deleting stops partway, the keys deleted so far are returned along with the error.
+func (c *Client) DeleteFiles(namespace, path string, recursive, confirm bool) (keys []string, err error) {
+	storage, provider, err := c.getFileStorage(namespace)
+	if err != nil {
+		return
+	}
+	if err = validateFilePath(provider, namespace, path); err != nil {
+		return
+	}
+
+	return deleteStorageFiles(storage, namespace, path, recursive, confirm)
+}
+
+// deleteStorageFiles deletes the file or directory at path in storage, see DeleteFiles
+func deleteStorageFiles(storage Storage, namespace, path string, recursive, confirm bool) (deleted []string, err error) {
+	keys, _, directory, err := resolveFilePath(storage, path)
+	if err != nil {
+		return
+	}
+	if directory && !recursive {
+		return nil, util.NewUserError(codes.FailedPrecondition, fmt.Sprintf("'%v' is a directory, set recursive to delete it and everything in it.", path))
+	}
+	if directory && !confirm {
+		return nil, util.NewUserError(codes.FailedPrecondition, fmt.Sprintf("Deleting '%v' removes %v files, set confirm to delete them.", path, len(keys)))
+	}
+
+	for _, key := range keys {
+		if err := storage.Delete(key); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Key":       key,
+				"Error":     err.Error(),
+			}).Error("Unable to delete file.")
+			return deleted, err
+		}
+		deleted = append(deleted, key)
+	}
+
+	return
+}
+
+// CopyFiles copies the file or directory at source to destination and returns the keys of the objects copied.
+// If destination ends with a slash, a file is copied into that directory. Existing files are never overwritten.
+// If copying stops partway, the keys copied so far are returned along with the error.
+func (c *Client) CopyFiles(namespace, source, destination string) (keys []string, err error) {
+	return c.transferFiles(namespace, source, destination, false)
+}
+
+// MoveFiles moves the file or directory at source to destination and returns the keys of the objects moved.
+// If destination ends with a slash, a file is moved into that directory. Existing files are never overwritten.
+// Objects are moved one at a time, so if moving stops partway, the keys moved so far are returned along with the error
+// and the rest are still at source.
+func (c *Client) MoveFiles(namespace, source, destination string) (keys []string, err error) {
+	return c.transferFiles(namespace, source, destination, true)
+}
+
+// transferFiles copies source to destination, and deletes source if move is set
+func (c *Client) transferFiles(namespace, source, destination string, move bool) (keys []string, err error) {
+	storage, provider, err := c.getFileStorage(namespace)
+	if err != nil {
+		return
+	}
+	if err = validateFilePath(provider, namespace, source); err != nil {
+		return
+	}
+	if err = validateFilePath(provider, namespace, destination); err != nil {
+		return
+	}
+
+	return transferStorageFiles(storage, namespace, source, destination, move)
+}
+
+// transferStorageFiles copies source to destination in storage, and deletes each object right after copying it if move is set
+func transferStorageFiles(storage Storage, namespace, source, destination string, move bool) (transferred []string, err error) {
+	destination = strings.TrimPrefix(destination, "/")
+	if destination == "" {
+		return nil, util.NewUserError(codes.InvalidArgument, "Destination is required.")
+	}
+
+	keys, prefix, directory, err := resolveFilePath(storage, source)
+	if err != nil {
+		return
+	}
+
+	destinations := make(map[string]string)
+	if directory {
+		if !strings.HasSuffix(destination, "/") {
+			destination += "/"
+		}
+		if err := validateArtifactKey(strings.TrimSuffix(destination, "/")); err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+		}
+		if strings.HasPrefix(destination, prefix) {
+			return nil, util.NewUserError(codes.InvalidArgument, "A directory can not be copied or moved into itself.")
+		}
+
+		existing, err := listStorageKeys(storage, destination)
+		if err != nil {
+			return nil, err
+		}
+		if len(existing) > 0 {
+			return nil, util.NewUserError(codes.AlreadyExists, fmt.Sprintf("Directory '%v' already exists.", destination))
+		}
+
+		for _, key := range keys {
+			destinations[key] = destination + strings.TrimPrefix(key, prefix)
+		}
+	} else {
+		if strings.HasSuffix(destination, "/") {
+			destination += FilePathToName(prefix)
+		}
+		if err := validateArtifactKey(destination); err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+		}
+
+		exists, err := storageObjectExists(storage, destination)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, util.NewUserError(codes.AlreadyExists, fmt.Sprintf("File '%v' already exists.", destination))
+		}
+
+		destinations[prefix] = destination
+	}
+
+	for _, key := range keys {
+		if err := storage.Copy(key, destinations[key]); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace":   namespace,
+				"Key":         key,
+				"Destination": destinations[key],
+				"Error":       err.Error(),
+			}).Error("Unable to copy file.")
+			return transferred, err
+		}
+
+		if move {
+			if err := storage.Delete(key); err != nil {
+				log.WithFields(log.Fields{
+					"Namespace": namespace,
+					"Key":       key,
+					"Error":     err.Error(),
+				}).Error("Unable to delete moved file.")
+				return transferred, err
+			}
+		}
+
+		transferred = append(transferred, key)
+	}
+
+	return
+}
+
+// CreateDirectory creates an empty directory at path.
+// Object storage has no directories, so this creates an empty object whose key ends with a slash.
+func (c *Client) CreateDirectory(namespace, path string) (*File, error) {
+	path = strings.TrimSuffix(strings.TrimPrefix(path, "/"), "/")
+	if err := validateArtifactKey(path); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	storage, provider, err := c.getFileStorage(namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateFilePath(provider, namespace, path); err != nil {
+		return nil, err
+	}
+
+	exists, err := storageObjectExists(storage, path)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, util.NewUserError(codes.AlreadyExists, fmt.Sprintf("A file named '%v' already exists.", path))
+	}
+
+	key := path + "/"
+	existing, err := listStorageKeys(storage, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, util.NewUserError(codes.AlreadyExists, fmt.Sprintf("Directory '%v' already exists.", key))
+	}
+
+	if err := putEmptyStorageObject(storage, key); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Key":       key,
+			"Error":     err.Error(),
+		}).Error("Unable to create directory.")
+		return nil, err
+	}
+
+	return &File{
+		Path:         key,
+		Name:         FilePathToName(key),
+		LastModified: time.Now().UTC(),
+		Directory:    true,
+	}, nil
+}
@@ -0,0 +1,129 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// Test_resolveFilePath makes sure files and directories are told apart, and directory marker objects are part of the directory
+func Test_resolveFilePath(t *testing.T) {
+	storage := memoryStorage{
+		"datasets/":           "",
+		"datasets/train.csv":  "a,b",
+		"datasets/test.csv":   "a,b",
+		"datasets/old/a.csv":  "a,b",
+		"datasets-backup.zip": "",
+	}
+
+	keys, prefix, directory, err := resolveFilePath(storage, "datasets/train.csv")
+	assert.Nil(t, err)
+	assert.False(t, directory)
+	assert.Equal(t, "datasets/train.csv", prefix)
+	assert.Equal(t, []string{"datasets/train.csv"}, keys)
+
+	keys, prefix, directory, err = resolveFilePath(storage, "/datasets")
+	assert.Nil(t, err)
+	assert.True(t, directory)
+	assert.Equal(t, "datasets/", prefix)
+	assert.Len(t, keys, 4)
+	assert.Contains(t, keys, "datasets/")
+
+	// An empty directory only has its marker
+	storage = memoryStorage{"models/": ""}
+	keys, _, directory, err = resolveFilePath(storage, "models")
+	assert.Nil(t, err)
+	assert.True(t, directory)
+	assert.Equal(t, []string{"models/"}, keys)
+
+	_, _, _, err = resolveFilePath(storage, "datasets")
+	assert.NotNil(t, err)
+
+	_, _, _, err = resolveFilePath(storage, "")
+	assert.NotNil(t, err)
+}
+
+// Test_resolveFilePath_FileSystem makes sure the filesystem storage, which has no marker objects, resolves directories too
+func Test_resolveFilePath_FileSystem(t *testing.T) {
+	storage := newTestFileSystemStorage(t,
+		"datasets/train.csv",
+		"datasets/test.csv",
+		"datasets/old/a.csv",
+		"datasets-backup.zip",
+	)
+
+	keys, prefix, directory, err := resolveFilePath(storage, "/datasets")
+	assert.Nil(t, err)
+	assert.True(t, directory)
+	assert.Equal(t, "datasets/", prefix)
+	assert.Len(t, keys, 3)
+}
+
+// failingCopyStorage fails to copy the object with key failKey
+type failingCopyStorage struct {
+	Storage
+	failKey string
+}
+
+func (s *failingCopyStorage) Copy(source, destination string) error {
+	if source == s.failKey {
+		return fmt.Errorf("copy failed")
+	}
+
+	return s.Storage.Copy(source, destination)
+}
+
+// Test_validateFilePath makes sure files and directories outside of the namespace's prefix are rejected
+func Test_validateFilePath(t *testing.T) {
+	provider := &ArtifactRepositoryProvider{
+		S3: &ArtifactRepositoryS3Provider{KeyFormat: "artifacts/{{workflow.namespace}}/{{workflow.name}}/{{pod.name}}"},
+	}
+
+	assert.Nil(t, validateFilePath(provider, "onepanel", "artifacts/onepanel/datasets/train.csv"))
+	assert.Nil(t, validateFilePath(provider, "onepanel", "/artifacts/onepanel"))
+	assert.Nil(t, validateFilePath(provider, "onepanel", ""))
+	assert.NotNil(t, validateFilePath(provider, "onepanel", "artifacts/other/datasets/train.csv"))
+	assert.NotNil(t, validateFilePath(provider, "onepanel", "artifacts"))
+	assert.NotNil(t, validateFilePath(provider, "onepanel", "artifacts/onepanel-dev/"))
+}
+
+// Test_transferStorageFiles_PartialMove makes sure a move that stops partway reports the keys moved and leaves the rest at the source
+func Test_transferStorageFiles_PartialMove(t *testing.T) {
+	storage := newTestFileSystemStorage(t,
+		"datasets/a.csv",
+		"datasets/b.csv",
+		"datasets/c.csv",
+	)
+
+	moved, err := transferStorageFiles(&failingCopyStorage{Storage: storage, failKey: "datasets/b.csv"}, "onepanel", "datasets", "backup", true)
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{"datasets/a.csv"}, moved)
+
+	source, err := listStorageKeys(storage, "datasets/")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"datasets/b.csv", "datasets/c.csv"}, source)
+
+	destination, err := listStorageKeys(storage, "backup/")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"backup/a.csv"}, destination)
+}
+
+// Test_transferStorageFiles_Copy makes sure copies keep the source
+func Test_transferStorageFiles_Copy(t *testing.T) {
+	storage := newTestFileSystemStorage(t, "datasets/a.csv")
+
+	copied, err := transferStorageFiles(storage, "onepanel", "datasets/a.csv", "backup/", false)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"datasets/a.csv"}, copied)
+
+	exists, err := storageObjectExists(storage, "backup/a.csv")
+	assert.Nil(t, err)
+	assert.True(t, exists)
+
+	exists, err = storageObjectExists(storage, "datasets/a.csv")
+	assert.Nil(t, err)
+	assert.True(t, exists)
+
+	_, err = transferStorageFiles(storage, "onepanel", "datasets/a.csv", "backup/", false)
+	assert.NotNil(t, err)
+}
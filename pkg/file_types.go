@@ -14,6 +14,8 @@ type File struct {
 	ContentType  string
 	LastModified time.Time
 	Directory    bool
+	URL          string // Presigned download URL, not set for directories
+	URLExpiresAt *time.Time
 }
 
 // FilePathToParentPath given a path, returns the parent path, assuming a '/' delimiter
@@ -0,0 +1,133 @@
+package v1
+
+import (
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"testing"
+)
+
+// Fixtures of the Kubernetes resources of workspaces. They are created in the test namespace, onepanel.
+
+// newTestPod creates a pod with the label of the workspace's StatefulSet
+func newTestPod(name, workspaceUID string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "onepanel",
+			Labels: map[string]string{
+				workspacePodLabelKey: workspaceUID,
+			},
+		},
+	}
+}
+
+// newTestVolumeClaim creates a claim for the volume of the workspace like the workspace's StatefulSet does
+func newTestVolumeClaim(volumeName, workspaceUID string) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workspaceVolumeClaimName(volumeName, workspaceUID),
+			Namespace: "onepanel",
+			Labels: map[string]string{
+				workspacePodLabelKey: workspaceUID,
+			},
+		},
+	}
+}
+
+// newTestResizedVolumeClaim creates a claim for the volume of the workspace that requests 40Gi with the capacity and condition
+func newTestResizedVolumeClaim(volumeName, workspaceUID, capacity string, conditionType corev1.PersistentVolumeClaimConditionType) *corev1.PersistentVolumeClaim {
+	claim := newTestVolumeClaim(volumeName, workspaceUID)
+	claim.Spec.Resources.Requests = corev1.ResourceList{
+		corev1.ResourceStorage: resource.MustParse("40Gi"),
+	}
+	claim.Status.Capacity = corev1.ResourceList{
+		corev1.ResourceStorage: resource.MustParse(capacity),
+	}
+	if conditionType != "" {
+		claim.Status.Conditions = []corev1.PersistentVolumeClaimCondition{
+			{Type: conditionType, Status: corev1.ConditionTrue, LastTransitionTime: metav1.Now()},
+		}
+	}
+
+	return claim
+}
+
+// newTestCopyJob creates a volume copy job of the cloned workspace with the condition
+func newTestCopyJob(name, workspaceUID string, conditionType batchv1.JobConditionType) *batchv1.Job {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "onepanel",
+			Labels: map[string]string{
+				workspaceCloneLabelKey: workspaceUID,
+			},
+		},
+	}
+	if conditionType != "" {
+		job.Status.Conditions = []batchv1.JobCondition{
+			{Type: conditionType, Status: corev1.ConditionTrue},
+		}
+	}
+
+	return job
+}
+
+// newTestVolumeSnapshotClass creates a VolumeSnapshotClass of the driver
+func newTestVolumeSnapshotClass(name, driver string, isDefault bool) *unstructured.Unstructured {
+	class := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": volumeSnapshotClassResource.GroupVersion().String(),
+			"kind":       "VolumeSnapshotClass",
+			"driver":     driver,
+		},
+	}
+	class.SetName(name)
+	if isDefault {
+		class.SetAnnotations(map[string]string{
+			"snapshot.storage.kubernetes.io/is-default-class": "true",
+		})
+	}
+
+	return class
+}
+
+// newTestVolumeSnapshot creates a VolumeSnapshot of a volume of the workspace
+func newTestVolumeSnapshot(name, workspaceUID string, status map[string]interface{}) *unstructured.Unstructured {
+	snapshot := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": volumeSnapshotResource.GroupVersion().String(),
+			"kind":       "VolumeSnapshot",
+			"status":     status,
+		},
+	}
+	snapshot.SetName(name)
+	snapshot.SetNamespace("onepanel")
+	snapshot.SetLabels(map[string]string{
+		workspaceLabelKey: workspaceUID,
+	})
+
+	return snapshot
+}
+
+// addTestWorkflowPodNode adds a pod node to the Argo workflow of the workflow execution
+func addTestWorkflowPodNode(t *testing.T, c *Client, namespace, uid, podName string) {
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if wf.Status.Nodes == nil {
+		wf.Status.Nodes = make(map[string]wfv1.NodeStatus)
+	}
+	wf.Status.Nodes[podName] = wfv1.NodeStatus{
+		ID:   podName,
+		Type: wfv1.NodeTypePod,
+	}
+	if _, err := c.ArgoprojV1alpha1().Workflows(namespace).Update(wf); err != nil {
+		t.Fatal(err)
+	}
+}
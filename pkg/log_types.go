@@ -0,0 +1,312 @@
+package v1
+
+import (
+	"bufio"
+	"container/heap"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LogFilter selects lines of a log. A line must match every filter that is set.
+type LogFilter struct {
+	// Query must be contained in the line, or match it if Regex is set
+	Query string
+	Regex bool
+	// Since and Until select the lines logged in [Since, Until)
+	Since *time.Time
+	Until *time.Time
+	// Limit is the maximum number of lines, 0 is unlimited
+	Limit int
+
+	pattern *regexp.Regexp
+}
+
+// NewLogFilter creates a LogFilter, compiling query if it is a regular expression
+func NewLogFilter(query string, regex bool, since, until *time.Time, limit int) (*LogFilter, error) {
+	filter := &LogFilter{
+		Query: query,
+		Regex: regex,
+		Since: since,
+		Until: until,
+		Limit: limit,
+	}
+
+	if limit < 0 {
+		return nil, fmt.Errorf("limit can not be negative")
+	}
+	if since != nil && until != nil && !since.Before(*until) {
+		return nil, fmt.Errorf("since must be before until")
+	}
+	if regex && query != "" {
+		pattern, err := regexp.Compile(query)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression: %v", err)
+		}
+		filter.pattern = pattern
+	}
+
+	return filter, nil
+}
+
+// IsEmpty returns true if the filter selects every line
+func (f *LogFilter) IsEmpty() bool {
+	return f == nil || (f.Query == "" && f.Since == nil && f.Until == nil && f.Limit == 0)
+}
+
+// matches returns true if the entry is selected by the filter
+func (f *LogFilter) matches(entry *LogEntry) bool {
+	if f.Since != nil && entry.Timestamp.Before(*f.Since) {
+		return false
+	}
+	if f.Until != nil && !entry.Timestamp.Before(*f.Until) {
+		return false
+	}
+	if f.pattern != nil {
+		return f.pattern.MatchString(entry.Content)
+	}
+
+	return strings.Contains(entry.Content, f.Query)
+}
+
+// parseLogLine splits a line of a log read with timestamps, such as "2020-05-01T10:00:00.000000001Z message", into its parts.
+// ok is false if the line does not start with a timestamp.
+func parseLogLine(line string) (timestamp time.Time, content string, ok bool) {
+	parts := strings.SplitN(line, " ", 2)
+	if len(parts) != 2 {
+		return time.Time{}, line, false
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, parts[0])
+	if err != nil {
+		return time.Time{}, line, false
+	}
+
+	return timestamp, parts[1], true
+}
+
+// filterLogLines reads the log line by line and calls emit with every raw line, and its parsed entry, selected by filter.
+// Lines without a timestamp, such as the rest of a multi-line message, have the timestamp of the line before them.
+// Logs are in chronological order, so reading stops at the first line after filter.Until.
+func filterLogLines(reader io.Reader, filter *LogFilter, emit func(line string, entry *LogEntry) error) error {
+	if filter == nil {
+		filter = &LogFilter{}
+	}
+
+	bufferedReader := bufio.NewReaderSize(reader, 64*1024)
+	lastTimestamp := time.Time{}
+	count := 0
+	for {
+		line, err := bufferedReader.ReadString('\n')
+		if len(line) > 0 {
+			entry := &LogEntry{
+				Timestamp: lastTimestamp,
+				Content:   line,
+			}
+			if timestamp, content, ok := parseLogLine(line); ok {
+				entry.Timestamp = timestamp
+				entry.Content = content
+				lastTimestamp = timestamp
+			}
+			entry.Content = strings.TrimRight(entry.Content, "\r\n")
+
+			if filter.Until != nil && !entry.Timestamp.IsZero() && !entry.Timestamp.Before(*filter.Until) {
+				return nil
+			}
+
+			if filter.matches(entry) {
+				if err := emit(line, entry); err != nil {
+					return err
+				}
+				count++
+				if filter.Limit > 0 && count >= filter.Limit {
+					return nil
+				}
+			}
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// logRing keeps the last entries added to it
+type logRing struct {
+	buffer []*LogEntry
+	next   int
+	full   bool
+}
+
+// newLogRing creates a logRing that keeps the last size entries
+func newLogRing(size int) *logRing {
+	return &logRing{
+		buffer: make([]*LogEntry, size),
+	}
+}
+
+// add adds the entry, replacing the oldest one if the ring is full
+func (r *logRing) add(entry *LogEntry) {
+	r.buffer[r.next] = entry
+	r.next = (r.next + 1) % len(r.buffer)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// entries returns the entries in the order they were added
+func (r *logRing) entries() []*LogEntry {
+	if !r.full {
+		return r.buffer[:r.next]
+	}
+
+	return append(append([]*LogEntry{}, r.buffer[r.next:]...), r.buffer[:r.next]...)
+}
+
+// logHeapEntry is a log entry in a logEntryHeap. sequence keeps entries with the same timestamp in the order they were added.
+type logHeapEntry struct {
+	*LogEntry
+	sequence uint64
+}
+
+// logEntryHeap is a min-heap of log entries ordered by timestamp
+type logEntryHeap []logHeapEntry
+
+func (h logEntryHeap) Len() int {
+	return len(h)
+}
+
+func (h logEntryHeap) Less(i, j int) bool {
+	if h[i].Timestamp.Equal(h[j].Timestamp) {
+		return h[i].sequence < h[j].sequence
+	}
+
+	return h[i].Timestamp.Before(h[j].Timestamp)
+}
+
+func (h logEntryHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *logEntryHeap) Push(x interface{}) {
+	*h = append(*h, x.(logHeapEntry))
+}
+
+func (h *logEntryHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	entry := old[n-1]
+	*h = old[:n-1]
+
+	return entry
+}
+
+// logAggregatorSource is one of the logs merged by a logAggregator
+type logAggregatorSource struct {
+	// live logs are still being written, so their next entries are at most the aggregator's delay old
+	live     bool
+	last     time.Time
+	finished bool
+}
+
+// logAggregator merges the entries of several logs, which are read concurrently, in timestamp order.
+// Entries are held back until none of the logs can add an earlier entry.
+type logAggregator struct {
+	mu       sync.Mutex
+	delay    time.Duration
+	entries  logEntryHeap
+	sequence uint64
+	sources  map[string]*logAggregatorSource
+}
+
+// newLogAggregator creates a logAggregator that holds back entries of live logs for delay
+func newLogAggregator(delay time.Duration) *logAggregator {
+	return &logAggregator{
+		delay:   delay,
+		entries: make(logEntryHeap, 0),
+		sources: make(map[string]*logAggregatorSource),
+	}
+}
+
+// addSource adds a log. It returns false if the log was already added.
+func (a *logAggregator) addSource(name string, live bool) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if _, ok := a.sources[name]; ok {
+		return false
+	}
+	a.sources[name] = &logAggregatorSource{live: live}
+
+	return true
+}
+
+// add adds an entry of the log
+func (a *logAggregator) add(name string, entry *LogEntry) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.sequence++
+	heap.Push(&a.entries, logHeapEntry{LogEntry: entry, sequence: a.sequence})
+	if source, ok := a.sources[name]; ok && entry.Timestamp.After(source.last) {
+		source.last = entry.Timestamp
+	}
+}
+
+// finish marks the log as read
+func (a *logAggregator) finish(name string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if source, ok := a.sources[name]; ok {
+		source.finished = true
+	}
+}
+
+// finished returns true if every log has been read
+func (a *logAggregator) finished() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, source := range a.sources {
+		if !source.finished {
+			return false
+		}
+	}
+
+	return true
+}
+
+// flush removes and returns the entries in timestamp order that no log can add an earlier entry before.
+// If all is set, every entry is returned.
+func (a *logAggregator) flush(now time.Time, all bool) (entries []*LogEntry) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	watermark := now.Add(-a.delay)
+	for _, source := range a.sources {
+		if source.finished {
+			continue
+		}
+		// An archived log is read from the start, so its next entry is no earlier than its last one
+		bound := source.last
+		if source.live && bound.Before(now.Add(-a.delay)) {
+			bound = now.Add(-a.delay)
+		}
+		if bound.Before(watermark) {
+			watermark = bound
+		}
+	}
+
+	for a.entries.Len() > 0 && (all || !a.entries[0].Timestamp.After(watermark)) {
+		entries = append(entries, heap.Pop(&a.entries).(logHeapEntry).LogEntry)
+	}
+
+	return
+}
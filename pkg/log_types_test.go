@@ -0,0 +1,128 @@
+package v1
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testLog = `2020-05-01T10:00:00.000000001Z epoch 1 loss 0.9
+2020-05-01T10:01:00.000000001Z epoch 2 loss 0.5
+Traceback (most recent call last):
+2020-05-01T10:02:00.000000001Z epoch 3 loss 0.3
+2020-05-01T10:03:00.000000001Z done
+`
+
+// filterTestLog returns the content of the lines of testLog selected by filter
+func filterTestLog(t *testing.T, filter *LogFilter) (contents []string) {
+	err := filterLogLines(strings.NewReader(testLog), filter, func(line string, entry *LogEntry) error {
+		contents = append(contents, entry.Content)
+		return nil
+	})
+	assert.Nil(t, err)
+
+	return
+}
+
+// TestNewLogFilter makes sure invalid filters are rejected
+func TestNewLogFilter(t *testing.T) {
+	_, err := NewLogFilter("loss [", true, nil, nil, 0)
+	assert.NotNil(t, err)
+
+	since := time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)
+	_, err = NewLogFilter("", false, &since, &since, 0)
+	assert.NotNil(t, err)
+
+	_, err = NewLogFilter("", false, nil, nil, -1)
+	assert.NotNil(t, err)
+
+	filter, err := NewLogFilter("loss [", false, nil, nil, 0)
+	assert.Nil(t, err)
+	assert.False(t, filter.IsEmpty())
+
+	filter, err = NewLogFilter("", false, nil, nil, 0)
+	assert.Nil(t, err)
+	assert.True(t, filter.IsEmpty())
+}
+
+// Test_filterLogLines_Query makes sure lines are selected by substring and regular expression
+func Test_filterLogLines_Query(t *testing.T) {
+	filter, _ := NewLogFilter("loss", false, nil, nil, 0)
+	assert.Equal(t, []string{"epoch 1 loss 0.9", "epoch 2 loss 0.5", "epoch 3 loss 0.3"}, filterTestLog(t, filter))
+
+	filter, _ = NewLogFilter(`loss 0\.[0-5]$`, true, nil, nil, 0)
+	assert.Equal(t, []string{"epoch 2 loss 0.5", "epoch 3 loss 0.3"}, filterTestLog(t, filter))
+
+	filter, _ = NewLogFilter("loss", false, nil, nil, 2)
+	assert.Len(t, filterTestLog(t, filter), 2)
+}
+
+// Test_filterLogLines_TimeRange makes sure lines are selected by time, and lines without a timestamp use the one before them
+func Test_filterLogLines_TimeRange(t *testing.T) {
+	since := time.Date(2020, 5, 1, 10, 1, 0, 0, time.UTC)
+	until := time.Date(2020, 5, 1, 10, 3, 0, 0, time.UTC)
+	filter, _ := NewLogFilter("", false, &since, &until, 0)
+
+	assert.Equal(t, []string{"epoch 2 loss 0.5", "Traceback (most recent call last):", "epoch 3 loss 0.3"}, filterTestLog(t, filter))
+}
+
+// logAggregatorContents returns the contents of the entries
+func logAggregatorContents(entries []*LogEntry) (contents []string) {
+	for _, entry := range entries {
+		contents = append(contents, entry.Content)
+	}
+
+	return
+}
+
+// Test_logRing makes sure only the last entries are kept, in the order they were added
+func Test_logRing(t *testing.T) {
+	ring := newLogRing(3)
+	ring.add(&LogEntry{Content: "1"})
+	ring.add(&LogEntry{Content: "2"})
+	assert.Equal(t, []string{"1", "2"}, logAggregatorContents(ring.entries()))
+
+	ring.add(&LogEntry{Content: "3"})
+	ring.add(&LogEntry{Content: "4"})
+	ring.add(&LogEntry{Content: "5"})
+	assert.Equal(t, []string{"3", "4", "5"}, logAggregatorContents(ring.entries()))
+}
+
+// Test_logAggregator_Order makes sure entries of several logs are merged in timestamp order once every log is read
+func Test_logAggregator_Order(t *testing.T) {
+	start := time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)
+	aggregator := newLogAggregator(time.Second)
+	assert.True(t, aggregator.addSource("a", false))
+	assert.True(t, aggregator.addSource("b", false))
+	assert.False(t, aggregator.addSource("a", false))
+
+	aggregator.add("a", &LogEntry{Timestamp: start, Content: "a1"})
+	aggregator.add("a", &LogEntry{Timestamp: start.Add(2 * time.Minute), Content: "a2"})
+	aggregator.add("b", &LogEntry{Timestamp: start.Add(time.Minute), Content: "b1"})
+
+	// b can still add entries after b1
+	assert.Equal(t, []string{"a1", "b1"}, logAggregatorContents(aggregator.flush(start.Add(time.Hour), false)))
+
+	aggregator.add("b", &LogEntry{Timestamp: start.Add(time.Minute), Content: "b2"})
+	aggregator.finish("b")
+	assert.False(t, aggregator.finished())
+	aggregator.finish("a")
+	assert.True(t, aggregator.finished())
+
+	assert.Equal(t, []string{"b2", "a2"}, logAggregatorContents(aggregator.flush(start.Add(time.Hour), false)))
+}
+
+// Test_logAggregator_Live makes sure entries of live logs are held back for the delay
+func Test_logAggregator_Live(t *testing.T) {
+	now := time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)
+	aggregator := newLogAggregator(2 * time.Second)
+	aggregator.addSource("a", true)
+	aggregator.addSource("b", true)
+
+	aggregator.add("a", &LogEntry{Timestamp: now.Add(-3 * time.Second), Content: "a1"})
+	aggregator.add("a", &LogEntry{Timestamp: now.Add(-time.Second), Content: "a2"})
+
+	assert.Equal(t, []string{"a1"}, logAggregatorContents(aggregator.flush(now, false)))
+	assert.Equal(t, []string{"a2"}, logAggregatorContents(aggregator.flush(now, true)))
+}
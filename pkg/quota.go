@@ -0,0 +1,164 @@
+package v1
+
+import (
+	"encoding/json"
+	sq "github.com/Masterminds/squirrel"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+// workspaceQuotaExcludedPhases are the phases of workspaces that do not count towards the quota
+var workspaceQuotaExcludedPhases = []WorkspacePhase{
+	WorkspacePaused,
+	WorkspaceRestoring,
+	WorkspaceTerminating,
+	WorkspaceTerminated,
+	WorkspaceFailedToLaunch,
+	WorkspaceFailedToResume,
+}
+
+// workspaceCountsTowardsQuota returns true if workspaces in the phase count towards the quota
+func workspaceCountsTowardsQuota(phase WorkspacePhase) bool {
+	for _, excluded := range workspaceQuotaExcludedPhases {
+		if phase == excluded {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parametersNodePool returns the value of the sys-node-pool parameter, or "" if there is none
+func parametersNodePool(parameters []Parameter) string {
+	for _, p := range parameters {
+		if p.Name == "sys-node-pool" && p.Value != nil {
+			return *p.Value
+		}
+	}
+
+	return ""
+}
+
+// countParametersNodePools adds the node pool of each of the JSON encoded parameters to nodePools
+func countParametersNodePools(nodePools map[string]int64, parametersBytes [][]byte) {
+	for _, data := range parametersBytes {
+		var parameters []Parameter
+		if err := json.Unmarshal(data, &parameters); err != nil {
+			continue
+		}
+		if nodePool := parametersNodePool(parameters); nodePool != "" {
+			nodePools[nodePool]++
+		}
+	}
+}
+
+// getNamespaceQuota returns the quota of the namespace, or nil if it has none
+func (c *Client) getNamespaceQuota(namespace string) (*NamespaceQuota, error) {
+	config, err := c.GetSystemConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return config.NamespaceQuota(namespace)
+}
+
+// GetQuotaUsage returns the quota of the namespace, nil if it has none, and what the namespace is using of it
+func (c *Client) GetQuotaUsage(namespace string) (quota *NamespaceQuota, usage *QuotaUsage, err error) {
+	quota, err = c.getNamespaceQuota(namespace)
+	if err != nil {
+		return
+	}
+
+	usage, err = c.getQuotaUsage(namespace)
+
+	return
+}
+
+// getQuotaUsage counts what the namespace is running the way NamespaceQuota limits it
+func (c *Client) getQuotaUsage(namespace string) (usage *QuotaUsage, err error) {
+	workspaceParameters := make([][]byte, 0)
+	query := sb.Select("parameters").
+		From("workspaces").
+		Where(sq.And{
+			sq.Eq{"namespace": namespace},
+			sq.NotEq{"phase": workspaceQuotaExcludedPhases},
+		})
+	if err = c.DB.Selectx(&workspaceParameters, query); err != nil {
+		return
+	}
+
+	// Workspace actions run system templates, the workspaces count instead
+	workflowExecutionParameters := make([][]byte, 0)
+	query = sb.Select("we.parameters").
+		From("workflow_executions we").
+		Join("workflow_template_versions wtv ON wtv.id = we.workflow_template_version_id").
+		Join("workflow_templates wt ON wt.id = wtv.workflow_template_id").
+		Where(sq.Eq{
+			"we.namespace":   namespace,
+			"we.phase":       []wfv1.NodePhase{wfv1.NodePending, wfv1.NodeRunning},
+			"we.finished_at": nil,
+			"wt.is_system":   false,
+		})
+	if err = c.DB.Selectx(&workflowExecutionParameters, query); err != nil {
+		return
+	}
+
+	usage = &QuotaUsage{
+		Workspaces:         int64(len(workspaceParameters)),
+		WorkflowExecutions: int64(len(workflowExecutionParameters)),
+		NodePools:          make(map[string]int64),
+	}
+	countParametersNodePools(usage.NodePools, workspaceParameters)
+	countParametersNodePools(usage.NodePools, workflowExecutionParameters)
+
+	return
+}
+
+// withQuota runs fn, which starts the workspaces and workflowExecutions on the nodePool, unless that would exceed
+// the quota of the namespace, then a ResourceExhausted error is returned.
+// The checks of a namespace are serialized until fn returns, so the next one counts what fn started.
+func (c *Client) withQuota(namespace string, workspaces, workflowExecutions int64, nodePool string, fn func() error) error {
+	return c.withQuotaCheck(namespace, func(quota *NamespaceQuota, usage *QuotaUsage) error {
+		return quota.Check(usage, workspaces, workflowExecutions, nodePool)
+	}, fn)
+}
+
+// withQuotaCheck runs fn unless check returns an error for the quota and usage of the namespace.
+// The checks of a namespace are serialized until fn returns, so the next one counts what fn started.
+func (c *Client) withQuotaCheck(namespace string, check func(quota *NamespaceQuota, usage *QuotaUsage) error, fn func() error) error {
+	quota, err := c.getNamespaceQuota(namespace)
+	if err != nil {
+		return err
+	}
+	if quota == nil {
+		return fn()
+	}
+
+	return c.DB.Exclusive(advisoryLockQuota, namespace, func() error {
+		usage, err := c.getQuotaUsage(namespace)
+		if err != nil {
+			return err
+		}
+		if err := check(quota, usage); err != nil {
+			return err
+		}
+
+		return fn()
+	})
+}
+
+// withWorkspaceQuota runs fn, which runs the workspace in phase with parameters instead of currentParameters.
+// A workspace that does not count towards the quota yet must fit in it,
+// and one that moves to another node pool must fit in that node pool's quota.
+func (c *Client) withWorkspaceQuota(namespace string, phase WorkspacePhase, currentParameters, parameters []Parameter, fn func() error) error {
+	nodePool := parametersNodePool(parameters)
+	if !workspaceCountsTowardsQuota(phase) {
+		return c.withQuota(namespace, 1, 0, nodePool, fn)
+	}
+	if nodePool == "" || nodePool == parametersNodePool(currentParameters) {
+		return fn()
+	}
+
+	return c.withQuotaCheck(namespace, func(quota *NamespaceQuota, usage *QuotaUsage) error {
+		return quota.CheckNodePool(usage, nodePool, 1)
+	}, fn)
+}
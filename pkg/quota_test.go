@@ -0,0 +1,176 @@
+package v1
+
+import (
+	"encoding/json"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newTestQuotaConfigMap returns the system config with a quota of one workspace
+func newTestQuotaConfigMap() *corev1.ConfigMap {
+	configMap := mockSystemConfigMap.DeepCopy()
+	configMap.Data["namespaceQuotas"] = `
+default:
+  workspaces: 1
+`
+
+	return configMap
+}
+
+// newTestNodePoolQuotaConfigMap returns the system config with a quota of one workspace on the Standard_NC6 node pool
+func newTestNodePoolQuotaConfigMap() *corev1.ConfigMap {
+	configMap := mockSystemConfigMap.DeepCopy()
+	configMap.Data["namespaceQuotas"] = `
+default:
+  nodePools:
+    Standard_NC6: 1
+`
+
+	return configMap
+}
+
+// setTestWorkspaceNodePool sets the phase of the workspace and its sys-node-pool parameter
+func setTestWorkspaceNodePool(t *testing.T, c *Client, uid string, phase WorkspacePhase, nodePool string) {
+	parameters, err := json.Marshal([]Parameter{{Name: "sys-node-pool", Value: ptr.String(nodePool)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = updateWorkspaceStatusBuilder("onepanel", uid, &WorkspaceStatus{Phase: phase}).
+		Set("parameters", parameters).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// createTestQuotaWorkspaces creates paused workspaces with the names
+func createTestQuotaWorkspaces(t *testing.T, c *Client, names ...string) {
+	workspaceTemplate, err := c.CreateWorkspaceTemplate("onepanel", &WorkspaceTemplate{
+		Name:     "test",
+		Manifest: jupyterLabWorkspaceManifest,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range names {
+		workspace := &Workspace{
+			Name:              name,
+			WorkspaceTemplate: workspaceTemplate,
+		}
+		if err := workspace.GenerateUID(workspace.Name); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.createWorkspace("onepanel", []byte("[]"), workspace); err != nil {
+			t.Fatal(err)
+		}
+		_, err = updateWorkspaceStatusBuilder("onepanel", workspace.UID, &WorkspaceStatus{Phase: WorkspacePaused}).
+			RunWith(c.DB).
+			Exec()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// TestClient_withQuota makes sure concurrent checks of a namespace count what the other one started
+func TestClient_withQuota(t *testing.T) {
+	c := NewTestClient(database, newTestQuotaConfigMap(), mockSystemSecret)
+	clearDatabase(t)
+	createTestQuotaWorkspaces(t, c, "jupyterlab", "vscode")
+
+	errs := make([]error, 2)
+	wg := sync.WaitGroup{}
+	for i, uid := range []string{"jupyterlab", "vscode"} {
+		wg.Add(1)
+		go func(i int, uid string) {
+			defer wg.Done()
+			errs[i] = c.withQuota("onepanel", 1, 0, "", func() error {
+				time.Sleep(100 * time.Millisecond)
+				_, err := updateWorkspaceStatusBuilder("onepanel", uid, &WorkspaceStatus{Phase: WorkspaceLaunching}).
+					RunWith(c.DB).
+					Exec()
+				return err
+			})
+		}(i, uid)
+	}
+	wg.Wait()
+
+	exceeded := 0
+	for _, err := range errs {
+		if err != nil {
+			assert.Equal(t, codes.ResourceExhausted, err.(*util.UserError).Code)
+			exceeded++
+		}
+	}
+	assert.Equal(t, 1, exceeded)
+
+	usage, err := c.getQuotaUsage("onepanel")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), usage.Workspaces)
+}
+
+// TestClient_ResumeWorkspace_Quota makes sure a paused workspace can not be resumed, by a user or its schedule,
+// if the namespace's quota is used up
+func TestClient_ResumeWorkspace_Quota(t *testing.T) {
+	c := NewTestClient(database, newTestQuotaConfigMap(), mockSystemSecret)
+	clearDatabase(t)
+	createTestQuotaWorkspaces(t, c, "jupyterlab", "vscode")
+	_, err := updateWorkspaceStatusBuilder("onepanel", "vscode", &WorkspaceStatus{Phase: WorkspaceRunning}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = c.ResumeWorkspace("onepanel", "jupyterlab")
+	assert.Equal(t, codes.ResourceExhausted, err.(*util.UserError).Code)
+
+	err = c.resumeWorkspace("onepanel", "jupyterlab", "Resumed by schedule.")
+	assert.Equal(t, codes.ResourceExhausted, err.(*util.UserError).Code)
+
+	workspace, err := c.GetWorkspace("onepanel", "jupyterlab")
+	assert.Nil(t, err)
+	assert.Equal(t, WorkspacePaused, workspace.Status.Phase)
+}
+
+// TestClient_UpdateWorkspace_NodePoolQuota makes sure a running workspace can not be updated or upgraded onto a full node pool
+func TestClient_UpdateWorkspace_NodePoolQuota(t *testing.T) {
+	c := NewTestClient(database, newTestNodePoolQuotaConfigMap(), mockSystemSecret)
+	clearDatabase(t)
+	createTestQuotaWorkspaces(t, c, "jupyterlab", "vscode")
+	setTestWorkspaceNodePool(t, c, "jupyterlab", WorkspaceRunning, "Standard_D4s_v3")
+	setTestWorkspaceNodePool(t, c, "vscode", WorkspaceRunning, "Standard_NC6")
+
+	err := c.UpdateWorkspace("onepanel", "jupyterlab", []Parameter{{Name: "sys-node-pool", Value: ptr.String("Standard_NC6")}})
+	assert.Equal(t, codes.ResourceExhausted, err.(*util.UserError).Code)
+
+	workspace, err := c.GetWorkspace("onepanel", "jupyterlab")
+	assert.Nil(t, err)
+	assert.Equal(t, WorkspaceRunning, workspace.Status.Phase)
+	assert.Equal(t, "Standard_D4s_v3", parametersNodePool(workspace.Parameters))
+
+	// Staying on the same node pool is not checked, and a free node pool fits
+	nodePool := []Parameter{{Name: "sys-node-pool", Value: ptr.String("Standard_NC6")}}
+	ran := false
+	assert.Nil(t, c.withWorkspaceQuota("onepanel", WorkspaceRunning, nodePool, nodePool, func() error {
+		ran = true
+		return nil
+	}))
+	assert.True(t, ran)
+
+	setTestWorkspaceNodePool(t, c, "vscode", WorkspaceRunning, "Standard_D4s_v3")
+	ran = false
+	assert.Nil(t, c.withWorkspaceQuota("onepanel", WorkspaceRunning, workspace.Parameters, nodePool, func() error {
+		ran = true
+		return nil
+	}))
+	assert.True(t, ran)
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/onepanelio/core/pkg/util"
+	"google.golang.org/grpc/codes"
+)
+
+// NamespaceQuota limits what a namespace can run at the same time. A limit of 0 means there is no limit.
+type NamespaceQuota struct {
+	// Workspaces is the number of workspaces that are not paused or terminated
+	Workspaces int64 `json:"workspaces"`
+	// WorkflowExecutions is the number of pending or running workflow executions, workspace actions are not counted
+	WorkflowExecutions int64 `json:"workflowExecutions"`
+	// NodePools is the number of workspaces and workflow executions by the value of the NodePoolOption they run on
+	NodePools map[string]int64 `json:"nodePools"`
+}
+
+// QuotaUsage is what a namespace is running, counted the way NamespaceQuota limits it
+type QuotaUsage struct {
+	Workspaces         int64
+	WorkflowExecutions int64
+	NodePools          map[string]int64
+}
+
+// Check returns a ResourceExhausted error if the usage plus the workspaces and workflowExecutions to start exceeds the quota.
+// nodePool is the node pool they run on, it is ignored if empty.
+func (q *NamespaceQuota) Check(usage *QuotaUsage, workspaces, workflowExecutions int64, nodePool string) error {
+	if q == nil {
+		return nil
+	}
+
+	if workspaces > 0 && q.Workspaces > 0 && usage.Workspaces+workspaces > q.Workspaces {
+		return util.NewUserError(codes.ResourceExhausted, fmt.Sprintf("Workspace quota exceeded, the namespace can run %v workspaces at the same time.", q.Workspaces))
+	}
+
+	if workflowExecutions > 0 && q.WorkflowExecutions > 0 && usage.WorkflowExecutions+workflowExecutions > q.WorkflowExecutions {
+		return util.NewUserError(codes.ResourceExhausted, fmt.Sprintf("Workflow execution quota exceeded, the namespace can run %v workflow executions at the same time.", q.WorkflowExecutions))
+	}
+
+	return q.CheckNodePool(usage, nodePool, workspaces+workflowExecutions)
+}
+
+// CheckNodePool returns a ResourceExhausted error if running count more workspaces or workflow executions on the nodePool
+// would exceed its quota
+func (q *NamespaceQuota) CheckNodePool(usage *QuotaUsage, nodePool string, count int64) error {
+	if q == nil || nodePool == "" {
+		return nil
+	}
+
+	limit := q.NodePools[nodePool]
+	if limit > 0 && usage.NodePools[nodePool]+count > limit {
+		return util.NewUserError(codes.ResourceExhausted, fmt.Sprintf("Node pool quota exceeded, the namespace can run %v workspaces and workflow executions on node pool %v at the same time.", limit, nodePool))
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"testing"
+)
+
+// TestNamespaceQuota_Check makes sure limits of 0 are unlimited and exceeded limits are ResourceExhausted errors
+func TestNamespaceQuota_Check(t *testing.T) {
+	quota := &NamespaceQuota{
+		Workspaces:         2,
+		WorkflowExecutions: 0,
+		NodePools: map[string]int64{
+			"Standard_NC6": 1,
+		},
+	}
+	usage := &QuotaUsage{
+		Workspaces:         1,
+		WorkflowExecutions: 50,
+		NodePools: map[string]int64{
+			"Standard_NC6": 1,
+		},
+	}
+
+	assert.Nil(t, quota.Check(usage, 1, 0, "Standard_D4s_v3"))
+	assert.Nil(t, quota.Check(usage, 0, 1, ""))
+
+	err := quota.Check(usage, 1, 0, "Standard_NC6")
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.ResourceExhausted, err.(*util.UserError).Code)
+
+	usage.Workspaces = 2
+	err = quota.Check(usage, 1, 0, "")
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.ResourceExhausted, err.(*util.UserError).Code)
+
+	var noQuota *NamespaceQuota
+	assert.Nil(t, noQuota.Check(usage, 1, 1, "Standard_NC6"))
+}
+
+// TestNamespaceQuota_CheckNodePool makes sure a workspace moved onto a full node pool is rejected
+func TestNamespaceQuota_CheckNodePool(t *testing.T) {
+	quota := &NamespaceQuota{
+		NodePools: map[string]int64{
+			"Standard_NC6": 1,
+		},
+	}
+	usage := &QuotaUsage{
+		NodePools: map[string]int64{
+			"Standard_NC6": 1,
+		},
+	}
+
+	assert.Nil(t, quota.CheckNodePool(usage, "Standard_D4s_v3", 1))
+	assert.Nil(t, quota.CheckNodePool(usage, "", 1))
+
+	err := quota.CheckNodePool(usage, "Standard_NC6", 1)
+	assert.Equal(t, codes.ResourceExhausted, err.(*util.UserError).Code)
+}
@@ -0,0 +1,132 @@
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"github.com/onepanelio/core/pkg/util"
+	"google.golang.org/grpc/codes"
+	"io"
+	"time"
+)
+
+var (
+	// ErrStorageObjectNotFound is returned by Storage if there is no object with the key
+	ErrStorageObjectNotFound = errors.New("object does not exist")
+	// ErrStoragePresignNotSupported is returned by Storage.Presign if the backend has no presigned URLs
+	ErrStoragePresignNotSupported = errors.New("presigned URLs are not supported")
+)
+
+// StorageObject is an object in Storage. When listing without recursion, Directory is set for sub directories.
+type StorageObject struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	LastModified time.Time
+	Directory    bool
+}
+
+// Storage is the artifact repository of a namespace.
+// Keys are slash separated, and directories are represented by empty objects whose key ends with a slash.
+type Storage interface {
+	// Stat returns the object with exactly this key, or ErrStorageObjectNotFound
+	Stat(key string) (*StorageObject, error)
+	// Get reads length bytes of the object starting at offset. A length of 0 reads until the end.
+	Get(key string, offset, length int64) (io.ReadCloser, error)
+	// List returns the objects whose key starts with prefix.
+	// If recursive is false, objects in sub directories are not returned and the sub directories are returned instead.
+	List(prefix string, recursive bool) ([]*StorageObject, error)
+	// Put creates or replaces the object with size bytes from reader. contentType is optional.
+	Put(key string, reader io.Reader, size int64, contentType string) error
+	Delete(key string) error
+	Copy(source, destination string) error
+	// Presign creates a URL that allows method (GET or PUT) requests to the object until expires.
+	// contentType is optional, if set an upload must send the same Content-Type header.
+	Presign(key, method, contentType string, expires time.Time) (string, error)
+}
+
+// compile time check that every backend implements Storage
+var (
+	_ Storage = &s3Storage{}
+	_ Storage = &gcsStorage{}
+	_ Storage = &azureStorage{}
+	_ Storage = &fileSystemStorage{}
+)
+
+// GetStorage returns the Storage of the namespace's artifact repository with the name, or of the default repository if repository is empty
+func (c *Client) GetStorage(namespace, repository string) (Storage, error) {
+	config, err := c.GetNamespaceConfig(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := config.GetArtifactRepository(repository)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getStorage(namespace, provider)
+}
+
+// getStorage returns the Storage of the configured provider
+func (c *Client) getStorage(namespace string, provider *ArtifactRepositoryProvider) (Storage, error) {
+	switch {
+	case provider.S3 != nil:
+		s3Client, err := c.GetS3Client(namespace, provider.S3)
+		if err != nil {
+			return nil, err
+		}
+		return &s3Storage{client: s3Client, bucket: provider.S3.Bucket}, nil
+	case provider.GCS != nil:
+		gcsClient, err := c.GetGCSClient(namespace, provider.GCS)
+		if err != nil {
+			return nil, err
+		}
+		return &gcsStorage{client: gcsClient, bucket: provider.GCS.Bucket, serviceAccountJSON: provider.GCS.ServiceAccountJSON}, nil
+	case provider.Azure != nil:
+		azureClient, err := c.GetAzureClient(namespace, provider.Azure)
+		if err != nil {
+			return nil, err
+		}
+		return &azureStorage{client: azureClient, container: provider.Azure.Container}, nil
+	case provider.FileSystem != nil:
+		root, err := fileSystemStorageRoot(artifactFileSystemRoot, namespace, provider.FileSystem.Path)
+		if err != nil {
+			return nil, err
+		}
+		return newFileSystemStorage(root)
+	}
+
+	return nil, util.NewUserError(codes.NotFound, "Artifact repository is not configured.")
+}
+
+// storageObjectExists returns true if there is an object with exactly this key
+func storageObjectExists(storage Storage, key string) (bool, error) {
+	_, err := storage.Stat(key)
+	if err == ErrStorageObjectNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// listStorageKeys returns the keys of every object under prefix, including those in sub directories
+func listStorageKeys(storage Storage, prefix string) (keys []string, err error) {
+	objects, err := storage.List(prefix, true)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, object := range objects {
+		keys = append(keys, object.Key)
+	}
+
+	return
+}
+
+// putEmptyStorageObject creates an empty object, which is how directories are represented
+func putEmptyStorageObject(storage Storage, key string) error {
+	return storage.Put(key, bytes.NewReader(nil), 0, "")
+}
@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"github.com/onepanelio/core/pkg/util/azure"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// azureStorage is Storage in an Azure Blob Storage container
+type azureStorage struct {
+	client    *azure.Client
+	container string
+}
+
+func (s *azureStorage) Stat(key string) (*StorageObject, error) {
+	properties, err := s.client.GetProperties(s.container, key)
+	if err != nil {
+		if azure.IsNotFound(err) {
+			return nil, ErrStorageObjectNotFound
+		}
+		return nil, err
+	}
+
+	return &StorageObject{
+		Key:          key,
+		Size:         properties.Size,
+		ContentType:  properties.ContentType,
+		LastModified: properties.LastModified,
+		Directory:    strings.HasSuffix(key, "/"),
+	}, nil
+}
+
+func (s *azureStorage) Get(key string, offset, length int64) (io.ReadCloser, error) {
+	var (
+		reader io.ReadCloser
+		err    error
+	)
+	if offset == 0 && length == 0 {
+		reader, err = s.client.GetBlob(s.container, key)
+	} else {
+		reader, err = s.client.GetBlobRange(s.container, key, offset, length)
+	}
+	if azure.IsNotFound(err) {
+		return nil, ErrStorageObjectNotFound
+	}
+
+	return reader, err
+}
+
+func (s *azureStorage) List(prefix string, recursive bool) (objects []*StorageObject, err error) {
+	delimiter := "/"
+	if recursive {
+		delimiter = ""
+	}
+
+	blobs, prefixes, err := s.client.ListBlobs(s.container, prefix, delimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, blobPrefix := range prefixes {
+		objects = append(objects, &StorageObject{Key: blobPrefix, Directory: true})
+	}
+	for _, blob := range blobs {
+		objects = append(objects, &StorageObject{
+			Key:          blob.Name,
+			Size:         blob.Size,
+			ContentType:  blob.ContentType,
+			LastModified: blob.LastModified,
+			Directory:    strings.HasSuffix(blob.Name, "/") && blob.Size == 0,
+		})
+	}
+
+	return
+}
+
+// Put uploads the blob in a single request, so it is read into memory first
+func (s *azureStorage) Put(key string, reader io.Reader, size int64, contentType string) error {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	return s.client.PutBlob(s.container, key, contentType, data)
+}
+
+func (s *azureStorage) Delete(key string) error {
+	return s.client.DeleteBlob(s.container, key)
+}
+
+func (s *azureStorage) Copy(source, destination string) error {
+	return s.client.CopyBlob(s.container, source, destination)
+}
+
+func (s *azureStorage) Presign(key, method, contentType string, expires time.Time) (string, error) {
+	permissions := "r"
+	if method == http.MethodPut {
+		permissions = "cw"
+	}
+
+	return s.client.SignedURL(s.container, key, permissions, expires), nil
+}
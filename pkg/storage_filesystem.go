@@ -0,0 +1,287 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/env"
+	"google.golang.org/grpc/codes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// artifactFileSystemRoot is the directory of filesystem artifact repositories, each namespace gets a sub directory.
+// Filesystem repositories are disabled if it is empty.
+var artifactFileSystemRoot = env.GetEnv("ARTIFACT_FILESYSTEM_ROOT", "")
+
+// fileSystemStorage is Storage in a directory of the local filesystem.
+// Directory keys, which end with a slash, are directories on disk.
+type fileSystemStorage struct {
+	root string
+}
+
+// fileSystemReader reads part of a file and closes the file
+type fileSystemReader struct {
+	io.Reader
+	file *os.File
+}
+
+func (r *fileSystemReader) Close() error {
+	return r.file.Close()
+}
+
+// fileSystemStorageRoot returns the directory of a namespace's filesystem repository.
+// It is the namespace's sub directory of root, the path of the provider can only select a directory inside of it.
+func fileSystemStorageRoot(root, namespace, providerPath string) (string, error) {
+	if root == "" {
+		return "", util.NewUserError(codes.FailedPrecondition, "Filesystem artifact repositories are disabled, set ARTIFACT_FILESYSTEM_ROOT to enable them.")
+	}
+	if err := validateArtifactKey(namespace); err != nil || strings.Contains(namespace, "/") {
+		return "", util.NewUserError(codes.InvalidArgument, "Namespace is not valid.")
+	}
+
+	providerPath = strings.Trim(providerPath, "/")
+	if providerPath == "" {
+		return filepath.Join(root, namespace), nil
+	}
+	if err := validateArtifactKey(providerPath); err != nil {
+		return "", util.NewUserError(codes.InvalidArgument, "Filesystem artifact repository path "+err.Error()+".")
+	}
+
+	return filepath.Join(root, namespace, filepath.FromSlash(providerPath)), nil
+}
+
+// newFileSystemStorage creates a Storage in the root directory, which is created if it does not exist
+func newFileSystemStorage(root string) (*fileSystemStorage, error) {
+	if root == "" {
+		return nil, fmt.Errorf("filesystem artifact repository path is required")
+	}
+
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, err
+	}
+
+	return &fileSystemStorage{root: root}, nil
+}
+
+// path returns the path of the key on disk. Keys can not point outside of root.
+func (s *fileSystemStorage) path(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if cleaned != "/"+strings.TrimSuffix(key, "/") {
+		return "", fmt.Errorf("invalid key '%v'", key)
+	}
+
+	return filepath.Join(s.root, filepath.FromSlash(cleaned)), nil
+}
+
+// key returns the key of a path on disk
+func (s *fileSystemStorage) key(filePath string, directory bool) string {
+	relative, _ := filepath.Rel(s.root, filePath)
+	key := filepath.ToSlash(relative)
+	if directory {
+		key += "/"
+	}
+
+	return key
+}
+
+func (s *fileSystemStorage) Stat(key string) (*StorageObject, error) {
+	filePath, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(filePath)
+	if os.IsNotExist(err) {
+		return nil, ErrStorageObjectNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Like object storage, a directory only exists under its key with a trailing slash
+	directory := strings.HasSuffix(key, "/")
+	if info.IsDir() != directory {
+		return nil, ErrStorageObjectNotFound
+	}
+
+	object := &StorageObject{
+		Key:          key,
+		LastModified: info.ModTime().UTC(),
+		Directory:    directory,
+	}
+	if !directory {
+		object.Size = info.Size()
+	}
+
+	return object, nil
+}
+
+func (s *fileSystemStorage) Get(key string, offset, length int64) (io.ReadCloser, error) {
+	if _, err := s.Stat(key); err != nil {
+		return nil, err
+	}
+
+	filePath, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	reader := &fileSystemReader{Reader: file, file: file}
+	if length > 0 {
+		reader.Reader = io.LimitReader(file, length)
+	}
+
+	return reader, nil
+}
+
+func (s *fileSystemStorage) List(prefix string, recursive bool) (objects []*StorageObject, err error) {
+	// Only the directory that contains prefix needs to be read
+	directory := prefix[:strings.LastIndex(prefix, "/")+1]
+	directoryPath, err := s.path(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	objects = make([]*StorageObject, 0)
+	err = filepath.Walk(directoryPath, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if filePath == directoryPath {
+			return nil
+		}
+
+		key := s.key(filePath, info.IsDir())
+		if !strings.HasPrefix(key, prefix) {
+			if info.IsDir() && !strings.HasPrefix(prefix, key) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		object := &StorageObject{
+			Key:          key,
+			LastModified: info.ModTime().UTC(),
+			Directory:    info.IsDir(),
+		}
+		if !info.IsDir() {
+			object.Size = info.Size()
+			objects = append(objects, object)
+			return nil
+		}
+
+		if !recursive {
+			objects = append(objects, object)
+			return filepath.SkipDir
+		}
+
+		// Only empty directories are objects, the others are implied by the files in them
+		entries, err := ioutil.ReadDir(filePath)
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			objects = append(objects, object)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Key < objects[j].Key
+	})
+
+	return
+}
+
+// Put creates the directory if key ends with a slash, otherwise it writes the file
+func (s *fileSystemStorage) Put(key string, reader io.Reader, size int64, contentType string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if strings.HasSuffix(key, "/") {
+		return os.MkdirAll(filePath, 0755)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return err
+	}
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, reader); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// Delete removes the file, and its parent directories once they are empty, like object storage does
+func (s *fileSystemStorage) Delete(key string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(filePath); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for directory := filepath.Dir(filePath); directory != s.root && strings.HasPrefix(directory, s.root); directory = filepath.Dir(directory) {
+		if os.Remove(directory) != nil {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (s *fileSystemStorage) Copy(source, destination string) error {
+	if strings.HasSuffix(source, "/") {
+		return s.Put(destination, nil, 0, "")
+	}
+
+	reader, err := s.Get(source, 0, 0)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	return s.Put(destination, reader, 0, "")
+}
+
+func (s *fileSystemStorage) Presign(key, method, contentType string, expires time.Time) (string, error) {
+	return "", ErrStoragePresignNotSupported
+}
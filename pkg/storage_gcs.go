@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"cloud.google.com/go/storage"
+	"github.com/onepanelio/core/pkg/util/gcs"
+	"golang.org/x/net/context"
+	"google.golang.org/api/iterator"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// gcsStorage is Storage in a Google Cloud Storage bucket
+type gcsStorage struct {
+	client             *gcs.Client
+	bucket             string
+	serviceAccountJSON string
+}
+
+func (s *gcsStorage) Stat(key string) (*StorageObject, error) {
+	attrs, err := s.client.Bucket(s.bucket).Object(key).Attrs(context.Background())
+	if err != nil {
+		if err == storage.ErrObjectNotExist {
+			return nil, ErrStorageObjectNotFound
+		}
+		return nil, err
+	}
+
+	return &StorageObject{
+		Key:          attrs.Name,
+		Size:         attrs.Size,
+		ContentType:  attrs.ContentType,
+		LastModified: attrs.Updated,
+		Directory:    strings.HasSuffix(attrs.Name, "/"),
+	}, nil
+}
+
+func (s *gcsStorage) Get(key string, offset, length int64) (io.ReadCloser, error) {
+	// NewRangeReader reads until the end if length is negative
+	if length == 0 {
+		length = -1
+	}
+
+	reader, err := s.client.Bucket(s.bucket).Object(key).NewRangeReader(context.Background(), offset, length)
+	if err == storage.ErrObjectNotExist {
+		return nil, ErrStorageObjectNotFound
+	}
+
+	return reader, err
+}
+
+func (s *gcsStorage) List(prefix string, recursive bool) (objects []*StorageObject, err error) {
+	query := &storage.Query{Prefix: prefix}
+	if !recursive {
+		query.Delimiter = "/"
+	}
+
+	it := s.client.Bucket(s.bucket).Objects(context.Background(), query)
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// With a delimiter, sub directories only have a Prefix
+		if attrs.Prefix != "" {
+			objects = append(objects, &StorageObject{Key: attrs.Prefix, Directory: true})
+			continue
+		}
+
+		objects = append(objects, &StorageObject{
+			Key:          attrs.Name,
+			Size:         attrs.Size,
+			ContentType:  attrs.ContentType,
+			LastModified: attrs.Updated,
+			Directory:    (attrs.Etag == "" || strings.HasSuffix(attrs.Name, "/")) && attrs.Size == 0,
+		})
+	}
+
+	return
+}
+
+func (s *gcsStorage) Put(key string, reader io.Reader, size int64, contentType string) error {
+	writer := s.client.Bucket(s.bucket).Object(key).NewWriter(context.Background())
+	writer.ContentType = contentType
+	if _, err := io.Copy(writer, reader); err != nil {
+		writer.Close()
+		return err
+	}
+
+	return writer.Close()
+}
+
+func (s *gcsStorage) Delete(key string) error {
+	return s.client.Bucket(s.bucket).Object(key).Delete(context.Background())
+}
+
+func (s *gcsStorage) Copy(source, destination string) error {
+	bucket := s.client.Bucket(s.bucket)
+	_, err := bucket.Object(destination).CopierFrom(bucket.Object(source)).Run(context.Background())
+
+	return err
+}
+
+func (s *gcsStorage) Presign(key, method, contentType string, expires time.Time) (string, error) {
+	if method != http.MethodPut {
+		contentType = ""
+	}
+
+	return gcs.SignedURL(s.serviceAccountJSON, s.bucket, key, method, contentType, expires)
+}
@@ -0,0 +1,106 @@
+package v1
+
+import (
+	minio "github.com/minio/minio-go/v6"
+	"github.com/onepanelio/core/pkg/util/s3"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// s3Storage is Storage in an S3 compatible bucket
+type s3Storage struct {
+	client *s3.Client
+	bucket string
+}
+
+func (s *s3Storage) Stat(key string) (*StorageObject, error) {
+	info, err := s.client.StatObject(s.bucket, key, s3.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ErrStorageObjectNotFound
+		}
+		return nil, err
+	}
+
+	return &StorageObject{
+		Key:          info.Key,
+		Size:         info.Size,
+		ContentType:  info.ContentType,
+		LastModified: info.LastModified,
+		Directory:    strings.HasSuffix(info.Key, "/"),
+	}, nil
+}
+
+func (s *s3Storage) Get(key string, offset, length int64) (io.ReadCloser, error) {
+	opts := s3.GetObjectOptions{}
+	if length > 0 {
+		if err := opts.SetRange(offset, offset+length-1); err != nil {
+			return nil, err
+		}
+	} else if offset > 0 {
+		if err := opts.SetRange(offset, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.client.GetObject(s.bucket, key, opts)
+}
+
+func (s *s3Storage) List(prefix string, recursive bool) (objects []*StorageObject, err error) {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	for objInfo := range s.client.ListObjectsV2(s.bucket, prefix, recursive, doneCh) {
+		if objInfo.Err != nil {
+			return nil, objInfo.Err
+		}
+		objects = append(objects, &StorageObject{
+			Key:          objInfo.Key,
+			Size:         objInfo.Size,
+			ContentType:  objInfo.ContentType,
+			LastModified: objInfo.LastModified,
+			Directory:    (objInfo.ETag == "" || strings.HasSuffix(objInfo.Key, "/")) && objInfo.Size == 0,
+		})
+	}
+
+	return
+}
+
+func (s *s3Storage) Put(key string, reader io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(s.bucket, key, reader, size, minio.PutObjectOptions{ContentType: contentType})
+
+	return err
+}
+
+func (s *s3Storage) Delete(key string) error {
+	return s.client.RemoveObject(s.bucket, key)
+}
+
+func (s *s3Storage) Copy(source, destination string) error {
+	dst, err := minio.NewDestinationInfo(s.bucket, destination, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	// ComposeObject copies in parts, so objects larger than the 5GB limit of a single copy are supported
+	return s.client.ComposeObject(dst, []minio.SourceInfo{minio.NewSourceInfo(s.bucket, source, nil)})
+}
+
+func (s *s3Storage) Presign(key, method, contentType string, expires time.Time) (string, error) {
+	expiration := time.Until(expires)
+	if method == http.MethodPut {
+		presigned, err := s.client.PresignedPutObject(s.bucket, key, expiration)
+		if err != nil {
+			return "", err
+		}
+		return presigned.String(), nil
+	}
+
+	presigned, err := s.client.PresignedGetObject(s.bucket, key, expiration, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return presigned.String(), nil
+}
@@ -0,0 +1,428 @@
+package v1
+
+import (
+	gcsstorage "cloud.google.com/go/storage"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	minio "github.com/minio/minio-go/v6"
+	"github.com/onepanelio/core/pkg/util/azure"
+	"github.com/onepanelio/core/pkg/util/gcs"
+	"github.com/onepanelio/core/pkg/util/s3"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/api/option"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// memoryStorage is Storage kept in memory for tests. Like object storage, directories only exist if they have a marker object.
+type memoryStorage map[string]string
+
+func (s memoryStorage) Stat(key string) (*StorageObject, error) {
+	data, ok := s[key]
+	if !ok {
+		return nil, ErrStorageObjectNotFound
+	}
+
+	return &StorageObject{Key: key, Size: int64(len(data)), Directory: strings.HasSuffix(key, "/")}, nil
+}
+
+func (s memoryStorage) Get(key string, offset, length int64) (io.ReadCloser, error) {
+	data, ok := s[key]
+	if !ok {
+		return nil, ErrStorageObjectNotFound
+	}
+	data = data[offset:]
+	if length > 0 {
+		data = data[:length]
+	}
+
+	return ioutil.NopCloser(strings.NewReader(data)), nil
+}
+
+func (s memoryStorage) List(prefix string, recursive bool) (objects []*StorageObject, err error) {
+	directories := make(map[string]bool)
+	for key, data := range s {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if i := strings.Index(key[len(prefix):], "/"); !recursive && i >= 0 && len(prefix)+i+1 < len(key) {
+			directories[key[:len(prefix)+i+1]] = true
+			continue
+		}
+		objects = append(objects, &StorageObject{Key: key, Size: int64(len(data)), Directory: strings.HasSuffix(key, "/")})
+	}
+	for directory := range directories {
+		if _, ok := s[directory]; !ok {
+			objects = append(objects, &StorageObject{Key: directory, Directory: true})
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Key < objects[j].Key
+	})
+
+	return
+}
+
+func (s memoryStorage) Put(key string, reader io.Reader, size int64, contentType string) error {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	s[key] = string(data)
+
+	return nil
+}
+
+func (s memoryStorage) Delete(key string) error {
+	delete(s, key)
+
+	return nil
+}
+
+func (s memoryStorage) Copy(source, destination string) error {
+	data, ok := s[source]
+	if !ok {
+		return ErrStorageObjectNotFound
+	}
+	s[destination] = data
+
+	return nil
+}
+
+func (s memoryStorage) Presign(key, method, contentType string, expires time.Time) (string, error) {
+	return "", ErrStoragePresignNotSupported
+}
+
+// newTestFileSystemStorage creates a fileSystemStorage in a temporary directory with the files in keys
+func newTestFileSystemStorage(t *testing.T, keys ...string) *fileSystemStorage {
+	root, err := ioutil.TempDir("", "storage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.RemoveAll(root)
+	})
+
+	storage, err := newFileSystemStorage(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range keys {
+		if err := storage.Put(key, strings.NewReader(key), 0, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return storage
+}
+
+// storageObjectKeys returns the keys of objects
+func storageObjectKeys(objects []*StorageObject) (keys []string) {
+	for _, object := range objects {
+		keys = append(keys, object.Key)
+	}
+
+	return
+}
+
+// TestFileSystemStorage_Get makes sure ranges of a file can be read
+func TestFileSystemStorage_Get(t *testing.T) {
+	storage := newTestFileSystemStorage(t, "logs/main.log")
+
+	object, err := storage.Stat("logs/main.log")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len("logs/main.log")), object.Size)
+	assert.False(t, object.Directory)
+
+	reader, err := storage.Get("logs/main.log", 5, 4)
+	assert.Nil(t, err)
+	data, _ := ioutil.ReadAll(reader)
+	reader.Close()
+	assert.Equal(t, "main", string(data))
+
+	reader, err = storage.Get("logs/main.log", 5, 0)
+	assert.Nil(t, err)
+	data, _ = ioutil.ReadAll(reader)
+	reader.Close()
+	assert.Equal(t, "main.log", string(data))
+
+	_, err = storage.Stat("logs")
+	assert.Equal(t, ErrStorageObjectNotFound, err)
+	_, err = storage.Get("logs/other.log", 0, 0)
+	assert.Equal(t, ErrStorageObjectNotFound, err)
+}
+
+// TestFileSystemStorage_List makes sure listing with and without recursion matches object storage
+func TestFileSystemStorage_List(t *testing.T) {
+	storage := newTestFileSystemStorage(t,
+		"datasets/train.csv",
+		"datasets/old/a.csv",
+		"datasets-backup.zip",
+		"models/",
+	)
+
+	objects, err := storage.List("datasets/", false)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"datasets/old/", "datasets/train.csv"}, storageObjectKeys(objects))
+	assert.True(t, objects[0].Directory)
+
+	objects, err = storage.List("datasets", true)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"datasets-backup.zip", "datasets/old/a.csv", "datasets/train.csv"}, storageObjectKeys(objects))
+
+	objects, err = storage.List("", true)
+	assert.Nil(t, err)
+	assert.Contains(t, storageObjectKeys(objects), "models/")
+
+	objects, err = storage.List("missing/", true)
+	assert.Nil(t, err)
+	assert.Empty(t, objects)
+}
+
+// TestFileSystemStorage_CopyDelete makes sure copies are independent and deleting the last file removes its directory
+func TestFileSystemStorage_CopyDelete(t *testing.T) {
+	storage := newTestFileSystemStorage(t, "a/b/file.txt")
+
+	assert.Nil(t, storage.Copy("a/b/file.txt", "c/file.txt"))
+	assert.Nil(t, storage.Delete("a/b/file.txt"))
+
+	exists, err := storageObjectExists(storage, "a/")
+	assert.Nil(t, err)
+	assert.False(t, exists)
+
+	exists, err = storageObjectExists(storage, "c/file.txt")
+	assert.Nil(t, err)
+	assert.True(t, exists)
+
+	_, err = storage.Presign("c/file.txt", "GET", "", time.Now())
+	assert.Equal(t, ErrStoragePresignNotSupported, err)
+}
+
+// TestFileSystemStorage_path makes sure keys can not escape the root directory
+func TestFileSystemStorage_path(t *testing.T) {
+	storage := newTestFileSystemStorage(t)
+
+	_, err := storage.path("../etc/passwd")
+	assert.NotNil(t, err)
+	_, err = storage.path("a/../../b")
+	assert.NotNil(t, err)
+	_, err = storage.path("a/b/")
+	assert.Nil(t, err)
+}
+
+// Test_fileSystemStorageRoot makes sure each namespace gets a directory of the server's root that the configmap can not leave
+func Test_fileSystemStorageRoot(t *testing.T) {
+	root, err := fileSystemStorageRoot("/data/artifacts", "onepanel", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "/data/artifacts/onepanel", root)
+
+	root, err = fileSystemStorageRoot("/data/artifacts", "onepanel", "/datasets/")
+	assert.Nil(t, err)
+	assert.Equal(t, "/data/artifacts/onepanel/datasets", root)
+
+	_, err = fileSystemStorageRoot("/data/artifacts", "onepanel", "../other")
+	assert.NotNil(t, err)
+	_, err = fileSystemStorageRoot("/data/artifacts", "..", "")
+	assert.NotNil(t, err)
+	_, err = fileSystemStorageRoot("", "onepanel", "/")
+	assert.NotNil(t, err)
+}
+
+// TestS3Storage makes sure S3 responses are converted to StorageObjects
+func TestS3Storage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodHead && r.URL.Path == "/raw-data/datasets/train.csv":
+			w.Header().Set("Content-Length", "3")
+			w.Header().Set("Content-Type", "text/csv")
+			w.Header().Set("ETag", `"abc"`)
+			w.Header().Set("Last-Modified", "Mon, 07 Sep 2020 10:00:00 GMT")
+		case r.Method == http.MethodHead:
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodGet && r.URL.Path == "/raw-data/":
+			assert.Equal(t, "2", r.URL.Query().Get("list-type"))
+			assert.Equal(t, "datasets/", r.URL.Query().Get("prefix"))
+			assert.Equal(t, "/", r.URL.Query().Get("delimiter"))
+			w.Header().Set("Content-Type", "application/xml")
+			fmt.Fprint(w, `<ListBucketResult>
+<Name>raw-data</Name><Prefix>datasets/</Prefix><KeyCount>2</KeyCount><IsTruncated>false</IsTruncated>
+<Contents><Key>datasets/train.csv</Key><Size>3</Size><ETag>"abc"</ETag><LastModified>2020-09-07T10:00:00.000Z</LastModified></Contents>
+<CommonPrefixes><Prefix>datasets/old/</Prefix></CommonPrefixes>
+</ListBucketResult>`)
+		default:
+			t.Errorf("unexpected request %v %v", r.Method, r.URL)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	minioClient, err := minio.NewWithRegion(strings.TrimPrefix(server.URL, "http://"), "access", "secret", false, "us-east-1")
+	assert.Nil(t, err)
+	storage := &s3Storage{client: &s3.Client{Client: minioClient}, bucket: "raw-data"}
+
+	object, err := storage.Stat("datasets/train.csv")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), object.Size)
+	assert.Equal(t, "text/csv", object.ContentType)
+	assert.False(t, object.Directory)
+
+	_, err = storage.Stat("datasets/missing.csv")
+	assert.Equal(t, ErrStorageObjectNotFound, err)
+
+	objects, err := storage.List("datasets/", false)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"datasets/train.csv", "datasets/old/"}, storageObjectKeys(objects))
+	for _, object := range objects {
+		assert.Equal(t, object.Key == "datasets/old/", object.Directory)
+	}
+
+	presigned, err := storage.Presign("datasets/train.csv", http.MethodPut, "", time.Now().Add(time.Minute))
+	assert.Nil(t, err)
+	presignedURL, err := url.Parse(presigned)
+	assert.Nil(t, err)
+	assert.Equal(t, "/raw-data/datasets/train.csv", presignedURL.Path)
+	assert.NotEmpty(t, presignedURL.Query().Get("X-Amz-Signature"))
+}
+
+// newTestServiceAccountJSON creates the JSON key of a service account with a new private key
+func newTestServiceAccountJSON(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	data, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"client_email": "artifacts@onepanel.iam.gserviceaccount.com",
+		"private_key":  string(privateKey),
+		"token_uri":    "https://oauth2.googleapis.com/token",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(data)
+}
+
+// TestGCSStorage makes sure GCS responses are converted to StorageObjects and the content type is only signed for uploads
+func TestGCSStorage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/storage/v1/b/raw-data/o/datasets/train.csv":
+			fmt.Fprint(w, `{"name": "datasets/train.csv", "size": "3", "contentType": "text/csv", "etag": "abc", "updated": "2020-09-07T10:00:00Z"}`)
+		case "/storage/v1/b/raw-data/o":
+			assert.Equal(t, "datasets/", r.URL.Query().Get("prefix"))
+			assert.Equal(t, "/", r.URL.Query().Get("delimiter"))
+			fmt.Fprint(w, `{"items": [{"name": "datasets/train.csv", "size": "3", "etag": "abc"}], "prefixes": ["datasets/old/"]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"code": 404, "message": "Not Found"}}`)
+		}
+	}))
+	defer server.Close()
+
+	client, err := gcsstorage.NewClient(context.Background(), option.WithEndpoint(server.URL+"/storage/v1/"), option.WithoutAuthentication())
+	assert.Nil(t, err)
+	storage := &gcsStorage{client: &gcs.Client{Client: client}, bucket: "raw-data", serviceAccountJSON: newTestServiceAccountJSON(t)}
+
+	object, err := storage.Stat("datasets/train.csv")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), object.Size)
+	assert.Equal(t, "text/csv", object.ContentType)
+	assert.False(t, object.Directory)
+
+	_, err = storage.Stat("datasets/missing.csv")
+	assert.Equal(t, ErrStorageObjectNotFound, err)
+
+	objects, err := storage.List("datasets/", false)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"datasets/train.csv", "datasets/old/"}, storageObjectKeys(objects))
+	for _, object := range objects {
+		assert.Equal(t, object.Key == "datasets/old/", object.Directory)
+	}
+
+	presigned, err := storage.Presign("datasets/train.csv", http.MethodPut, "text/csv", time.Now().Add(time.Minute))
+	assert.Nil(t, err)
+	presignedURL, err := url.Parse(presigned)
+	assert.Nil(t, err)
+	assert.Equal(t, "/raw-data/datasets/train.csv", presignedURL.Path)
+	assert.Equal(t, "content-type;host", presignedURL.Query().Get("X-Goog-SignedHeaders"))
+
+	presigned, err = storage.Presign("datasets/train.csv", http.MethodGet, "text/csv", time.Now().Add(time.Minute))
+	assert.Nil(t, err)
+	presignedURL, err = url.Parse(presigned)
+	assert.Nil(t, err)
+	assert.Equal(t, "host", presignedURL.Query().Get("X-Goog-SignedHeaders"))
+}
+
+// TestAzureStorage makes sure Azure responses are converted to StorageObjects and uploads get write permissions
+func TestAzureStorage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodHead && r.URL.Path == "/devstoreaccount1/onepanel/datasets/train.csv":
+			w.Header().Set("Content-Length", "3")
+			w.Header().Set("Content-Type", "text/csv")
+		case r.Method == http.MethodHead:
+			w.WriteHeader(http.StatusNotFound)
+		case r.URL.Query().Get("comp") == "list":
+			assert.Equal(t, "/", r.URL.Query().Get("delimiter"))
+			fmt.Fprint(w, `<EnumerationResults><Blobs>
+<Blob><Name>datasets/train.csv</Name><Properties><Content-Length>3</Content-Length></Properties></Blob>
+<BlobPrefix><Name>datasets/old/</Name></BlobPrefix>
+</Blobs><NextMarker /></EnumerationResults>`)
+		default:
+			t.Errorf("unexpected request %v %v", r.Method, r.URL)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client, err := azure.NewClient(azure.Config{
+		Account:    "devstoreaccount1",
+		AccountKey: "a2V5",
+		Endpoint:   server.URL + "/devstoreaccount1",
+	})
+	assert.Nil(t, err)
+	storage := &azureStorage{client: client, container: "onepanel"}
+
+	object, err := storage.Stat("datasets/train.csv")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), object.Size)
+	assert.Equal(t, "text/csv", object.ContentType)
+
+	_, err = storage.Stat("datasets/missing.csv")
+	assert.Equal(t, ErrStorageObjectNotFound, err)
+
+	objects, err := storage.List("datasets/", false)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"datasets/old/", "datasets/train.csv"}, storageObjectKeys(objects))
+	assert.True(t, objects[0].Directory)
+	assert.False(t, objects[1].Directory)
+
+	presigned, err := storage.Presign("datasets/train.csv", http.MethodPut, "", time.Now().Add(time.Minute))
+	assert.Nil(t, err)
+	presignedURL, err := url.Parse(presigned)
+	assert.Nil(t, err)
+	assert.Equal(t, "cw", presignedURL.Query().Get("sp"))
+
+	presigned, err = storage.Presign("datasets/train.csv", http.MethodGet, "", time.Now().Add(time.Minute))
+	assert.Nil(t, err)
+	presignedURL, err = url.Parse(presigned)
+	assert.Nil(t, err)
+	assert.Equal(t, "r", presignedURL.Query().Get("sp"))
+}
@@ -0,0 +1,500 @@
+package v1
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/env"
+	"github.com/onepanelio/core/pkg/util/pagination"
+	"github.com/onepanelio/core/pkg/util/types"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"math/rand"
+	"strconv"
+	"time"
+)
+
+var sweepMaxExecutions = env.GetEnv("SWEEP_MAX_EXECUTIONS", "1000")
+
+// sweepSelectBuilder selects sweeps with the uid and version of their workflow template
+func sweepSelectBuilder(namespace string) sq.SelectBuilder {
+	return sb.Select(getSweepColumns("s")...).
+		Columns(`wt.uid "workflow_template.uid"`, `wt.name "workflow_template.name"`, `wtv.version "workflow_template.version"`).
+		From("sweeps s").
+		Join("workflow_template_versions wtv ON wtv.id = s.workflow_template_version_id").
+		Join("workflow_templates wt ON wt.id = wtv.workflow_template_id").
+		Where(sq.Eq{
+			"s.namespace": namespace,
+		})
+}
+
+// CreateSweep creates the sweep and starts its first executions.
+//
+// Required fields
+// * Name
+// * Strategy
+// * SweepParameters
+// * WorkflowTemplate.UID, WorkflowTemplate.Version. If version is 0, the latest version is used.
+// * MaxExecutions, for the random strategy
+func (c *Client) CreateSweep(namespace string, sweep *Sweep) (*Sweep, error) {
+	maxExecutions, err := strconv.Atoi(sweepMaxExecutions)
+	if err != nil {
+		return nil, err
+	}
+
+	if sweep.Name == "" {
+		return nil, util.NewUserError(codes.InvalidArgument, "Sweep name is required.")
+	}
+	if sweep.WorkflowTemplate == nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Workflow template is required.")
+	}
+	if sweep.MaxParallelism < 1 {
+		sweep.MaxParallelism = 1
+	}
+	if sweep.ObjectiveGoal == "" {
+		sweep.ObjectiveGoal = SweepObjectiveMaximize
+	}
+	if sweep.ObjectiveGoal != SweepObjectiveMaximize && sweep.ObjectiveGoal != SweepObjectiveMinimize {
+		return nil, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Unknown objective goal '%v'.", sweep.ObjectiveGoal))
+	}
+	if sweep.EarlyStopThreshold != nil && sweep.ObjectiveMetric == "" {
+		return nil, util.NewUserError(codes.InvalidArgument, "Early stop requires an objective metric.")
+	}
+
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	sweep.Trials, err = GenerateSweepTrials(sweep.Strategy, sweep.SweepParameters, sweep.MaxExecutions, maxExecutions, rng)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	workflowTemplate, err := c.GetWorkflowTemplate(namespace, sweep.WorkflowTemplate.UID, sweep.WorkflowTemplate.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sweep.GenerateUID(sweep.Name); err != nil {
+		return nil, err
+	}
+	if sweep.Parameters == nil {
+		sweep.Parameters = make([]Parameter, 0)
+	}
+	if sweep.Labels == nil {
+		sweep.Labels = make(types.JSONLabels)
+	}
+
+	parametersJSON, err := json.Marshal(sweep.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	sweepParametersJSON, err := json.Marshal(sweep.SweepParameters)
+	if err != nil {
+		return nil, err
+	}
+	trialsJSON, err := json.Marshal(sweep.Trials)
+	if err != nil {
+		return nil, err
+	}
+
+	sweep.Namespace = namespace
+	sweep.Phase = SweepRunning
+	sweep.WorkflowTemplate = workflowTemplate
+	sweep.WorkflowTemplateVersionID = workflowTemplate.WorkflowTemplateVersionID
+
+	err = sb.Insert("sweeps").
+		SetMap(sq.Eq{
+			"uid":                          sweep.UID,
+			"name":                         sweep.Name,
+			"namespace":                    namespace,
+			"phase":                        sweep.Phase,
+			"strategy":                     sweep.Strategy,
+			"workflow_template_version_id": sweep.WorkflowTemplateVersionID,
+			"parameters":                   string(parametersJSON),
+			"sweep_parameters":             string(sweepParametersJSON),
+			"trials":                       string(trialsJSON),
+			"max_parallelism":              sweep.MaxParallelism,
+			"objective_metric":             sweep.ObjectiveMetric,
+			"objective_goal":               sweep.ObjectiveGoal,
+			"early_stop_threshold":         sweep.EarlyStopThreshold,
+			"labels":                       sweep.Labels,
+		}).
+		Suffix("RETURNING id, created_at").
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&sweep.ID, &sweep.CreatedAt)
+	if err != nil {
+		return nil, util.NewUserErrorWrap(err, "Sweep")
+	}
+
+	if err := c.reconcileSweep(sweep); err != nil {
+		return nil, err
+	}
+
+	return c.GetSweep(namespace, sweep.UID)
+}
+
+// GetSweep returns the sweep, or a NotFound error if it does not exist
+func (c *Client) GetSweep(namespace, uid string) (sweep *Sweep, err error) {
+	query := sweepSelectBuilder(namespace).
+		Where(sq.Eq{"s.uid": uid})
+
+	sweep = &Sweep{}
+	if err = c.DB.Getx(sweep, query); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, util.NewUserError(codes.NotFound, "Sweep not found.")
+		}
+		return nil, err
+	}
+
+	if err := sweep.LoadFromBytes(); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// ListSweeps returns the sweeps matching the labels, most recently created first
+func (c *Client) ListSweeps(namespace string, paginator *pagination.PaginationRequest, labels []*Label) (sweeps []*Sweep, err error) {
+	query := sweepSelectBuilder(namespace).
+		OrderBy("s.created_at DESC", "s.id DESC")
+	query = *paginator.ApplyToSelect(&query, "s.created_at", "s.id")
+	if len(labels) > 0 {
+		labelsJSON, err := LabelsToJSONString(labels)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where("s.labels @> ?", labelsJSON)
+	}
+
+	sweeps = make([]*Sweep, 0)
+	if err = c.DB.Selectx(&sweeps, query); err != nil {
+		return nil, err
+	}
+
+	for _, sweep := range sweeps {
+		if err := sweep.LoadFromBytes(); err != nil {
+			return nil, err
+		}
+	}
+
+	return
+}
+
+// CountSweeps returns the number of sweeps matching the labels
+func (c *Client) CountSweeps(namespace string, labels []*Label) (count int, err error) {
+	query := sb.Select("COUNT(*)").
+		From("sweeps s").
+		Where(sq.Eq{"s.namespace": namespace})
+	if len(labels) > 0 {
+		labelsJSON, err := LabelsToJSONString(labels)
+		if err != nil {
+			return 0, err
+		}
+		query = query.Where("s.labels @> ?", labelsJSON)
+	}
+
+	err = query.RunWith(c.DB).
+		QueryRow().
+		Scan(&count)
+
+	return
+}
+
+// TerminateSweep stops the sweep from starting new executions and terminates the ones that are running
+func (c *Client) TerminateSweep(namespace, uid string) error {
+	sweep, err := c.GetSweep(namespace, uid)
+	if err != nil {
+		return err
+	}
+
+	if sweep.Phase != SweepRunning {
+		return util.NewUserError(codes.FailedPrecondition, "Sweep is not running.")
+	}
+
+	return c.finishSweep(sweep, SweepTerminated)
+}
+
+// sweepExecutionsFilter selects the workflow executions created by the sweep
+func sweepExecutionsFilter(sweep *Sweep, phases ...wfv1.NodePhase) *WorkflowExecutionFilter {
+	return &WorkflowExecutionFilter{
+		Labels: []*Label{{Key: sweepUIDLabelKey, Value: sweep.UID}},
+		Phases: phases,
+	}
+}
+
+// finishSweep sets the final phase of the sweep and terminates the executions that are still running
+func (c *Client) finishSweep(sweep *Sweep, phase SweepPhase) error {
+	_, err := sb.Update("sweeps").
+		SetMap(sq.Eq{
+			"phase":       phase,
+			"finished_at": time.Now().UTC(),
+			"modified_at": time.Now().UTC(),
+		}).
+		Where(sq.Eq{
+			"id":    sweep.ID,
+			"phase": SweepRunning,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+	sweep.Phase = phase
+
+	if phase == SweepSucceeded {
+		return nil
+	}
+
+	uids, err := c.ListWorkflowExecutionUIDs(sweep.Namespace, "", sweepExecutionsFilter(sweep, wfv1.NodePending, wfv1.NodeRunning))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.BatchWorkflowExecutions(sweep.Namespace, WorkflowExecutionBatchTerminate, uids)
+
+	return err
+}
+
+// updateSweepBestExecution records the best execution of the sweep so far and how many executions were evaluated.
+// Only the executions that succeeded since the last update are compared against the current best.
+func (c *Client) updateSweepBestExecution(sweep *Sweep, completed int) error {
+	if sweep.ObjectiveMetric != "" {
+		filter := sweepExecutionsFilter(sweep, wfv1.NodeSucceeded)
+		// CompareWorkflowExecutions only uses stored metrics, so store the ones of executions that just finished
+		query := workflowExecutionsSelectBuilderNoColumns(sweep.Namespace, sweep.WorkflowTemplate.UID, "", filter).
+			Columns("we.id", "we.namespace", "we.uid")
+		if err := c.storeMissingWorkflowExecutionMetrics(query); err != nil {
+			return err
+		}
+
+		comparisons, _, err := c.CompareWorkflowExecutions(sweep.Namespace, sweep.WorkflowTemplate.UID, "", pagination.Start(1), filter, &WorkflowExecutionMetricSort{
+			Metric:     sweep.ObjectiveMetric,
+			Descending: sweep.ObjectiveGoal != SweepObjectiveMinimize,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(comparisons) > 0 {
+			best := comparisons[0]
+			if metric := best.GetMetric(sweep.ObjectiveMetric); metric != nil && sweep.IsBetter(metric.Value) {
+				value := metric.Value
+				sweep.BestMetricValue = &value
+				sweep.BestWorkflowExecutionUID = &best.WorkflowExecution.UID
+			}
+		}
+	}
+
+	sweep.CompletedExecutions = completed
+	_, err := sb.Update("sweeps").
+		SetMap(sq.Eq{
+			"completed_executions":        sweep.CompletedExecutions,
+			"best_workflow_execution_uid": sweep.BestWorkflowExecutionUID,
+			"best_metric_value":           sweep.BestMetricValue,
+			"modified_at":                 time.Now().UTC(),
+		}).
+		Where(sq.Eq{"id": sweep.ID}).
+		RunWith(c.DB).
+		Exec()
+
+	return err
+}
+
+// claimSweepTrial reserves the next trial of the sweep so it is only started once.
+// It returns false if the trial was already claimed.
+func (c *Client) claimSweepTrial(sweep *Sweep) (bool, error) {
+	result, err := sb.Update("sweeps").
+		Set("launched_executions", sweep.LaunchedExecutions+1).
+		Set("modified_at", time.Now().UTC()).
+		Where(sq.Eq{
+			"id":                  sweep.ID,
+			"phase":               SweepRunning,
+			"launched_executions": sweep.LaunchedExecutions,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected == 1, nil
+}
+
+// startSweepTrial creates the workflow execution of the trial
+func (c *Client) startSweepTrial(sweep *Sweep, workflowTemplate *WorkflowTemplate, trial int) error {
+	labels := make(types.JSONLabels)
+	for key, value := range sweep.Labels {
+		labels[key] = value
+	}
+
+	workflowExecution := &WorkflowExecution{
+		Parameters: sweep.TrialParameters(trial),
+		Labels:     labels,
+	}
+	_, err := c.createLabeledWorkflowExecution(sweep.Namespace, workflowExecution, workflowTemplate, map[string]string{
+		sweepUIDLabelKey:   sweep.UID,
+		sweepTrialLabelKey: strconv.Itoa(trial),
+	})
+
+	return err
+}
+
+// reconcileSweep moves a running sweep forward: it records the best execution, stops the sweep early
+// if the objective threshold was reached, starts new executions up to the max parallelism,
+// and finishes the sweep once all of its executions are done.
+func (c *Client) reconcileSweep(sweep *Sweep) error {
+	if sweep.Phase != SweepRunning {
+		return nil
+	}
+
+	running, err := c.CountWorkflowExecutions(sweep.Namespace, "", "", sweepExecutionsFilter(sweep, wfv1.NodePending, wfv1.NodeRunning))
+	if err != nil {
+		return err
+	}
+	all, err := c.CountWorkflowExecutions(sweep.Namespace, "", "", sweepExecutionsFilter(sweep))
+	if err != nil {
+		return err
+	}
+	completed := all - running
+
+	if completed != sweep.CompletedExecutions {
+		if err := c.updateSweepBestExecution(sweep, completed); err != nil {
+			return err
+		}
+	}
+
+	if sweep.ReachedEarlyStopThreshold() {
+		return c.finishSweep(sweep, SweepStopped)
+	}
+
+	if sweep.LaunchedExecutions >= len(sweep.Trials) {
+		if running == 0 {
+			return c.finishSweep(sweep, SweepSucceeded)
+		}
+		return nil
+	}
+
+	if running >= sweep.MaxParallelism {
+		return nil
+	}
+
+	workflowTemplate, err := c.GetWorkflowTemplate(sweep.Namespace, sweep.WorkflowTemplate.UID, sweep.WorkflowTemplate.Version)
+	if err != nil {
+		return err
+	}
+
+	for ; running < sweep.MaxParallelism && sweep.LaunchedExecutions < len(sweep.Trials); running++ {
+		claimed, err := c.claimSweepTrial(sweep)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			// Another reconciliation is handling this sweep
+			return nil
+		}
+
+		trial := sweep.LaunchedExecutions
+		sweep.LaunchedExecutions++
+		if err := c.startSweepTrial(sweep, workflowTemplate, trial); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": sweep.Namespace,
+				"UID":       sweep.UID,
+				"Trial":     trial,
+				"Error":     err.Error(),
+			}).Error("Unable to start sweep execution.")
+
+			// The trial is started once the namespace's quota has room for it
+			if userErr, ok := err.(*util.UserError); ok && userErr.Code == codes.ResourceExhausted {
+				return c.releaseSweepTrial(sweep)
+			}
+
+			if err := c.failSweepTrial(sweep, trial, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// releaseSweepTrial undoes the claim of the last trial of the sweep, so it is started by a later reconciliation
+func (c *Client) releaseSweepTrial(sweep *Sweep) error {
+	_, err := sb.Update("sweeps").
+		Set("launched_executions", sweep.LaunchedExecutions-1).
+		Set("modified_at", time.Now().UTC()).
+		Where(sq.Eq{
+			"id":                  sweep.ID,
+			"launched_executions": sweep.LaunchedExecutions,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+	sweep.LaunchedExecutions--
+
+	return nil
+}
+
+// failSweepTrial records that the execution of the trial could not be created
+func (c *Client) failSweepTrial(sweep *Sweep, trial int, trialErr error) error {
+	failure := SweepTrialFailure{
+		Trial: trial,
+		Error: trialErr.Error(),
+	}
+	failureJSON, err := json.Marshal([]SweepTrialFailure{failure})
+	if err != nil {
+		return err
+	}
+
+	_, err = sb.Update("sweeps").
+		Set("failed_trials", sq.Expr("failed_trials || ?::jsonb", string(failureJSON))).
+		Set("modified_at", time.Now().UTC()).
+		Where(sq.Eq{"id": sweep.ID}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+	sweep.FailedTrials = append(sweep.FailedTrials, failure)
+
+	return nil
+}
+
+// ReconcileSweeps moves every running sweep forward, see reconcileSweep.
+// Errors for individual sweeps are logged and do not stop the others.
+func (c *Client) ReconcileSweeps() error {
+	query := sb.Select("s.namespace", "s.uid").
+		From("sweeps s").
+		Where(sq.Eq{"s.phase": SweepRunning}).
+		OrderBy("s.id")
+
+	sweeps := make([]*Sweep, 0)
+	if err := c.DB.Selectx(&sweeps, query); err != nil {
+		return err
+	}
+
+	for _, s := range sweeps {
+		sweep, err := c.GetSweep(s.Namespace, s.UID)
+		if err == nil {
+			err = c.reconcileSweep(sweep)
+		}
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": s.Namespace,
+				"UID":       s.UID,
+				"Error":     err.Error(),
+			}).Error("Unable to reconcile sweep.")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// TestClient_CreateSweep_Quota makes sure trials over the namespace's quota are started later instead of being lost
+func TestClient_CreateSweep_Quota(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+
+	config, err := c.GetSystemConfig()
+	assert.Nil(t, err)
+	config["namespaceQuotas"] = `
+default:
+  workflowExecutions: 1
+`
+
+	wt := &WorkflowTemplate{
+		Name:     "test",
+		Manifest: defaultWorkflowTemplate,
+	}
+	wt, _ = c.CreateWorkflowTemplate(namespace, wt)
+
+	sweep, err := c.CreateSweep(namespace, &Sweep{
+		Name:             "test",
+		Strategy:         SweepStrategyGrid,
+		WorkflowTemplate: &WorkflowTemplate{UID: wt.UID},
+		SweepParameters: []*SweepParameter{
+			{Name: "learning-rate", Values: []string{"0.1", "0.01", "0.001"}},
+		},
+		MaxParallelism: 3,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, sweep.LaunchedExecutions)
+	assert.Len(t, sweep.FailedTrials, 0)
+
+	count, err := c.CountWorkflowExecutions(namespace, "", "", sweepExecutionsFilter(sweep))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	err = c.failSweepTrial(sweep, 1, errors.New("invalid workflow"))
+	assert.Nil(t, err)
+
+	sweep, err = c.GetSweep(namespace, sweep.UID)
+	assert.Nil(t, err)
+	assert.Equal(t, []SweepTrialFailure{{Trial: 1, Error: "invalid workflow"}}, sweep.FailedTrials)
+}
@@ -0,0 +1,287 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/onepanelio/core/pkg/util/sql"
+	"github.com/onepanelio/core/pkg/util/types"
+	uid2 "github.com/onepanelio/core/pkg/util/uid"
+	"math"
+	"math/rand"
+	"strconv"
+	"time"
+)
+
+// SweepStrategy is how a sweep chooses the parameter values of its executions
+type SweepStrategy string
+
+// SweepPhase is the state of a sweep
+type SweepPhase string
+
+// SweepObjectiveGoal is whether the objective metric of a sweep should be as high or as low as possible
+type SweepObjectiveGoal string
+
+const (
+	// SweepStrategyGrid runs an execution for every combination of the parameter values
+	SweepStrategyGrid SweepStrategy = "grid"
+	// SweepStrategyRandom runs a fixed number of executions with randomly chosen parameter values
+	SweepStrategyRandom SweepStrategy = "random"
+
+	SweepRunning    SweepPhase = "Running"
+	SweepSucceeded  SweepPhase = "Succeeded"
+	SweepStopped    SweepPhase = "Stopped"
+	SweepTerminated SweepPhase = "Terminated"
+
+	SweepObjectiveMaximize SweepObjectiveGoal = "maximize"
+	SweepObjectiveMinimize SweepObjectiveGoal = "minimize"
+
+	// sweepUIDLabelKey is the workflow execution label with the uid of the sweep that created it
+	sweepUIDLabelKey = "onepanel.io/sweep-uid"
+	// sweepTrialLabelKey is the workflow execution label with the index of the trial the execution runs
+	sweepTrialLabelKey = "onepanel.io/sweep-trial"
+)
+
+// SweepParameter is a parameter whose value changes between the executions of a sweep.
+// Values are used by both strategies. If there are no Values, the random strategy picks a number between Min and Max.
+type SweepParameter struct {
+	Name    string   `json:"name"`
+	Values  []string `json:"values,omitempty"`
+	Min     float64  `json:"min,omitempty"`
+	Max     float64  `json:"max,omitempty"`
+	Integer bool     `json:"integer,omitempty"`
+}
+
+// SweepTrialFailure is a trial of a sweep whose execution could not be created
+type SweepTrialFailure struct {
+	Trial int    `json:"trial"`
+	Error string `json:"error"`
+}
+
+// Sweep runs a workflow template many times over a grid or random search of parameter values
+type Sweep struct {
+	ID                        uint64
+	CreatedAt                 time.Time  `db:"created_at"`
+	ModifiedAt                *time.Time `db:"modified_at"`
+	FinishedAt                *time.Time `db:"finished_at"`
+	UID                       string
+	Name                      string
+	Namespace                 string
+	Phase                     SweepPhase
+	Strategy                  SweepStrategy
+	WorkflowTemplate          *WorkflowTemplate `db:"workflow_template"`
+	WorkflowTemplateVersionID uint64            `db:"workflow_template_version_id"`
+	Parameters                []Parameter
+	ParametersBytes           []byte `db:"parameters"` // to load from database
+	SweepParameters           []*SweepParameter
+	SweepParametersBytes      []byte `db:"sweep_parameters"` // to load from database
+	Trials                    []map[string]string
+	TrialsBytes               []byte `db:"trials"` // to load from database
+	MaxExecutions             int    // Number of executions of a random search, only used when creating the sweep
+	MaxParallelism            int    `db:"max_parallelism"`
+	LaunchedExecutions        int    `db:"launched_executions"`
+	CompletedExecutions       int    `db:"completed_executions"`
+	FailedTrials              []SweepTrialFailure
+	FailedTrialsBytes         []byte             `db:"failed_trials"` // to load from database
+	ObjectiveMetric           string             `db:"objective_metric"`
+	ObjectiveGoal             SweepObjectiveGoal `db:"objective_goal"`
+	EarlyStopThreshold        *float64           `db:"early_stop_threshold"`
+	BestWorkflowExecutionUID  *string            `db:"best_workflow_execution_uid"`
+	BestMetricValue           *float64           `db:"best_metric_value"`
+	Labels                    types.JSONLabels
+}
+
+// GenerateUID generates a uid from the input name and sets it on the sweep
+func (s *Sweep) GenerateUID(name string) error {
+	result, err := uid2.GenerateUID(name, 30)
+	if err != nil {
+		return err
+	}
+
+	s.UID = result
+
+	return nil
+}
+
+// LoadFromBytes loads Parameters, SweepParameters, Trials and FailedTrials from their database fields
+func (s *Sweep) LoadFromBytes() error {
+	s.Parameters = make([]Parameter, 0)
+	if err := json.Unmarshal(s.ParametersBytes, &s.Parameters); err != nil {
+		return err
+	}
+
+	s.SweepParameters = make([]*SweepParameter, 0)
+	if err := json.Unmarshal(s.SweepParametersBytes, &s.SweepParameters); err != nil {
+		return err
+	}
+
+	s.Trials = make([]map[string]string, 0)
+	if err := json.Unmarshal(s.TrialsBytes, &s.Trials); err != nil {
+		return err
+	}
+
+	s.FailedTrials = make([]SweepTrialFailure, 0)
+	if s.FailedTrialsBytes != nil {
+		if err := json.Unmarshal(s.FailedTrialsBytes, &s.FailedTrials); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsBetter returns true if value is a better objective metric value than the current best
+func (s *Sweep) IsBetter(value float64) bool {
+	if s.BestMetricValue == nil {
+		return true
+	}
+
+	if s.ObjectiveGoal == SweepObjectiveMinimize {
+		return value < *s.BestMetricValue
+	}
+
+	return value > *s.BestMetricValue
+}
+
+// ReachedEarlyStopThreshold returns true if the best objective metric value is at least as good as the early stop threshold
+func (s *Sweep) ReachedEarlyStopThreshold() bool {
+	if s.EarlyStopThreshold == nil || s.BestMetricValue == nil {
+		return false
+	}
+
+	if s.ObjectiveGoal == SweepObjectiveMinimize {
+		return *s.BestMetricValue <= *s.EarlyStopThreshold
+	}
+
+	return *s.BestMetricValue >= *s.EarlyStopThreshold
+}
+
+// TrialParameters returns the parameters of the execution for the trial: the sweep's parameters overridden by the trial's values
+func (s *Sweep) TrialParameters(trial int) []Parameter {
+	values := s.Trials[trial]
+
+	parameters := make([]Parameter, 0, len(s.Parameters)+len(values))
+	used := make(map[string]bool)
+	for _, parameter := range s.Parameters {
+		if value, ok := values[parameter.Name]; ok {
+			value := value
+			parameter.Value = &value
+			used[parameter.Name] = true
+		}
+		parameters = append(parameters, parameter)
+	}
+
+	for _, sweepParameter := range s.SweepParameters {
+		if used[sweepParameter.Name] {
+			continue
+		}
+		value := values[sweepParameter.Name]
+		parameters = append(parameters, Parameter{
+			Name:  sweepParameter.Name,
+			Value: &value,
+		})
+	}
+
+	return parameters
+}
+
+// GenerateSweepTrials returns the swept parameter values of each execution.
+// For the grid strategy, every combination of values is returned, up to maxTrials.
+// For the random strategy, executions trials are returned, with values chosen using rng.
+func GenerateSweepTrials(strategy SweepStrategy, parameters []*SweepParameter, executions, maxTrials int, rng *rand.Rand) (trials []map[string]string, err error) {
+	if len(parameters) == 0 {
+		return nil, fmt.Errorf("at least one sweep parameter is required")
+	}
+
+	names := make(map[string]bool)
+	for _, parameter := range parameters {
+		if parameter.Name == "" {
+			return nil, fmt.Errorf("sweep parameter name is required")
+		}
+		if names[parameter.Name] {
+			return nil, fmt.Errorf("sweep parameter '%v' is repeated", parameter.Name)
+		}
+		names[parameter.Name] = true
+	}
+
+	switch strategy {
+	case SweepStrategyGrid:
+		total := 1
+		for _, parameter := range parameters {
+			if len(parameter.Values) == 0 {
+				return nil, fmt.Errorf("sweep parameter '%v' requires values for a grid search", parameter.Name)
+			}
+			total *= len(parameter.Values)
+			if total > maxTrials {
+				return nil, fmt.Errorf("grid search has more than %v executions", maxTrials)
+			}
+		}
+
+		trials = []map[string]string{{}}
+		for _, parameter := range parameters {
+			expanded := make([]map[string]string, 0, len(trials)*len(parameter.Values))
+			for _, trial := range trials {
+				for _, value := range parameter.Values {
+					next := make(map[string]string, len(trial)+1)
+					for k, v := range trial {
+						next[k] = v
+					}
+					next[parameter.Name] = value
+					expanded = append(expanded, next)
+				}
+			}
+			trials = expanded
+		}
+	case SweepStrategyRandom:
+		if executions < 1 {
+			return nil, fmt.Errorf("random search requires a number of executions")
+		}
+		if executions > maxTrials {
+			return nil, fmt.Errorf("random search has more than %v executions", maxTrials)
+		}
+		for _, parameter := range parameters {
+			if len(parameter.Values) == 0 && parameter.Max < parameter.Min {
+				return nil, fmt.Errorf("sweep parameter '%v' has a max lower than its min", parameter.Name)
+			}
+		}
+
+		trials = make([]map[string]string, executions)
+		for i := range trials {
+			trials[i] = make(map[string]string)
+			for _, parameter := range parameters {
+				trials[i][parameter.Name] = parameter.randomValue(rng)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown sweep strategy '%v'", strategy)
+	}
+
+	return
+}
+
+// randomValue picks one of the values, or a number between Min and Max if there are none
+func (p *SweepParameter) randomValue(rng *rand.Rand) string {
+	if len(p.Values) > 0 {
+		return p.Values[rng.Intn(len(p.Values))]
+	}
+
+	if p.Integer {
+		min := int64(math.Ceil(p.Min))
+		max := int64(math.Floor(p.Max))
+		if max <= min {
+			return strconv.FormatInt(min, 10)
+		}
+		return strconv.FormatInt(min+rng.Int63n(max-min+1), 10)
+	}
+
+	return strconv.FormatFloat(p.Min+rng.Float64()*(p.Max-p.Min), 'g', -1, 64)
+}
+
+// getSweepColumns returns all of the columns for sweeps modified by alias, destination.
+// see formatColumnSelect
+func getSweepColumns(aliasAndDestination ...string) []string {
+	columns := []string{"id", "created_at", "modified_at", "finished_at", "uid", "name", "namespace", "phase", "strategy",
+		"workflow_template_version_id", "parameters", "sweep_parameters", "trials", "max_parallelism", "launched_executions",
+		"completed_executions", "failed_trials", "objective_metric", "objective_goal", "early_stop_threshold", "best_workflow_execution_uid",
+		"best_metric_value", "labels"}
+	return sql.FormatColumnSelect(columns, aliasAndDestination...)
+}
@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+// TestGenerateSweepTrials_Grid makes sure every combination of values is generated
+func TestGenerateSweepTrials_Grid(t *testing.T) {
+	parameters := []*SweepParameter{
+		{Name: "learning-rate", Values: []string{"0.1", "0.01", "0.001"}},
+		{Name: "batch-size", Values: []string{"32", "64"}},
+	}
+
+	trials, err := GenerateSweepTrials(SweepStrategyGrid, parameters, 0, 100, nil)
+	assert.Nil(t, err)
+	assert.Len(t, trials, 6)
+
+	seen := make(map[string]bool)
+	for _, trial := range trials {
+		seen[trial["learning-rate"]+"/"+trial["batch-size"]] = true
+	}
+	assert.Len(t, seen, 6)
+
+	_, err = GenerateSweepTrials(SweepStrategyGrid, parameters, 0, 5, nil)
+	assert.NotNil(t, err)
+}
+
+// TestGenerateSweepTrials_Random makes sure random values are within their bounds
+func TestGenerateSweepTrials_Random(t *testing.T) {
+	parameters := []*SweepParameter{
+		{Name: "optimizer", Values: []string{"adam", "sgd"}},
+		{Name: "epochs", Min: 1, Max: 10, Integer: true},
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	trials, err := GenerateSweepTrials(SweepStrategyRandom, parameters, 20, 100, rng)
+	assert.Nil(t, err)
+	assert.Len(t, trials, 20)
+
+	for _, trial := range trials {
+		assert.Contains(t, []string{"adam", "sgd"}, trial["optimizer"])
+		epochs, err := strconv.Atoi(trial["epochs"])
+		assert.Nil(t, err)
+		assert.True(t, epochs >= 1 && epochs <= 10)
+	}
+
+	_, err = GenerateSweepTrials(SweepStrategyRandom, parameters, 0, 100, rng)
+	assert.NotNil(t, err)
+}
+
+// TestSweep_ReachedEarlyStopThreshold makes sure the objective goal is respected
+func TestSweep_ReachedEarlyStopThreshold(t *testing.T) {
+	threshold := 0.1
+	best := 0.05
+	sweep := &Sweep{
+		ObjectiveGoal:      SweepObjectiveMinimize,
+		EarlyStopThreshold: &threshold,
+		BestMetricValue:    &best,
+	}
+	assert.True(t, sweep.ReachedEarlyStopThreshold())
+	assert.True(t, sweep.IsBetter(0.01))
+	assert.False(t, sweep.IsBetter(0.2))
+
+	sweep.ObjectiveGoal = SweepObjectiveMaximize
+	assert.False(t, sweep.ReachedEarlyStopThreshold())
+	assert.True(t, sweep.IsBetter(0.2))
+}
@@ -56,6 +56,10 @@ type ConfigMap struct {
 type LogEntry struct {
 	Timestamp time.Time
 	Content   string
+	// NodeName, PodName and ContainerName are only set when the logs of several containers are merged
+	NodeName      string
+	PodName       string
+	ContainerName string
 }
 
 type Metric struct {
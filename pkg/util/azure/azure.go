@@ -0,0 +1,474 @@
+package azure
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// apiVersion is the version of the Blob service REST API used by Client
+const apiVersion = "2018-11-09"
+
+const (
+	// responseTimeout is how long a request waits for the response headers.
+	// Bodies are streamed, so the time to read them is not limited.
+	responseTimeout = 30 * time.Second
+	// defaultCopyTimeout is how long CopyBlob waits for an asynchronous copy if Config does not set it
+	defaultCopyTimeout = 10 * time.Minute
+)
+
+// Config is the information needed to access an Azure storage account.
+// Endpoint is optional, it defaults to https://{account}.blob.core.windows.net.
+// Set it to use an emulator such as Azurite, for example http://127.0.0.1:10000/devstoreaccount1
+type Config struct {
+	Account    string
+	AccountKey string
+	Endpoint   string
+	// CopyTimeout is how long CopyBlob waits for an asynchronous copy, it defaults to 10 minutes
+	CopyTimeout time.Duration
+}
+
+// Client is a minimal client for the Azure Blob Storage REST API, authorized with the account's shared key.
+type Client struct {
+	account     string
+	key         []byte
+	endpoint    *url.URL
+	httpClient  *http.Client
+	copyTimeout time.Duration
+}
+
+// Blob is an entry returned by ListBlobs
+type Blob struct {
+	Name         string
+	Size         int64
+	ContentType  string
+	LastModified time.Time
+}
+
+// BlobProperties are the properties of a single blob
+type BlobProperties struct {
+	Size         int64
+	ContentType  string
+	LastModified time.Time
+	CopyStatus   string
+}
+
+// Error is an error returned by the Blob service
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("azure blob storage: status %v", e.StatusCode)
+	}
+
+	return fmt.Sprintf("azure blob storage: %v: %v", e.Code, e.Message)
+}
+
+// IsNotFound returns true if err means the blob or container does not exist
+func IsNotFound(err error) bool {
+	azureErr, ok := err.(*Error)
+
+	return ok && azureErr.StatusCode == http.StatusNotFound
+}
+
+// NewClient creates a client for the storage account in config
+func NewClient(config Config) (*Client, error) {
+	if config.Account == "" {
+		return nil, fmt.Errorf("azure storage account is required")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(config.AccountKey)
+	if err != nil {
+		return nil, fmt.Errorf("azure storage account key is not valid base64: %v", err)
+	}
+
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%v.blob.core.windows.net", config.Account)
+	}
+	endpointURL, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	copyTimeout := config.CopyTimeout
+	if copyTimeout <= 0 {
+		copyTimeout = defaultCopyTimeout
+	}
+
+	return &Client{
+		account:     config.Account,
+		key:         key,
+		endpoint:    endpointURL,
+		httpClient:  newHTTPClient(),
+		copyTimeout: copyTimeout,
+	}, nil
+}
+
+// newHTTPClient creates a client that gives up on unresponsive servers.
+// http.Client.Timeout is not used because it would also cut off long downloads of large blobs.
+func newHTTPClient() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ResponseHeaderTimeout: responseTimeout,
+			ExpectContinueTimeout: time.Second,
+			IdleConnTimeout:       90 * time.Second,
+			MaxIdleConnsPerHost:   10,
+		},
+	}
+}
+
+// blobURL returns the url of the blob, or of the container if blob is empty
+func (c *Client) blobURL(container, blob string, query url.Values) *url.URL {
+	segments := []string{url.PathEscape(container)}
+	if blob != "" {
+		for _, segment := range strings.Split(blob, "/") {
+			segments = append(segments, url.PathEscape(segment))
+		}
+	}
+
+	result := *c.endpoint
+	result.RawPath = c.endpoint.EscapedPath() + "/" + strings.Join(segments, "/")
+	result.Path, _ = url.PathUnescape(result.RawPath)
+	result.RawQuery = query.Encode()
+
+	return &result
+}
+
+// sign computes the shared key signature of the string
+func (c *Client) sign(stringToSign string) string {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write([]byte(stringToSign))
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// authorize adds the headers that authorize the request with the shared key.
+// See https://docs.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+func (c *Client) authorize(req *http.Request) {
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-ms-version", apiVersion)
+
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+
+	msHeaders := make([]string, 0)
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, "x-ms-") {
+			msHeaders = append(msHeaders, name+":"+strings.Join(values, ","))
+		}
+	}
+	sort.Strings(msHeaders)
+
+	resource := "/" + c.account + req.URL.EscapedPath()
+	query := req.URL.Query()
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		values := query[name]
+		sort.Strings(values)
+		resource += "\n" + strings.ToLower(name) + ":" + strings.Join(values, ",")
+	}
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // Date, x-ms-date is used instead
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+		strings.Join(msHeaders, "\n"),
+		resource,
+	}, "\n")
+
+	req.Header.Set("Authorization", "SharedKey "+c.account+":"+c.sign(stringToSign))
+}
+
+// do sends an authorized request and returns an *Error if the response is not successful.
+// The caller must close the body of the response.
+func (c *Client) do(method string, u *url.URL, headers http.Header, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(body))
+	for name, values := range headers {
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+	c.authorize(req)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		defer res.Body.Close()
+		result := &Error{StatusCode: res.StatusCode}
+		data, _ := ioutil.ReadAll(res.Body)
+		_ = xml.Unmarshal(data, &struct {
+			Code    *string `xml:"Code"`
+			Message *string `xml:"Message"`
+		}{&result.Code, &result.Message})
+		return nil, result
+	}
+
+	return res, nil
+}
+
+// GetProperties returns the properties of the blob
+func (c *Client) GetProperties(container, blob string) (*BlobProperties, error) {
+	res, err := c.do(http.MethodHead, c.blobURL(container, blob, nil), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	properties := &BlobProperties{
+		Size:        res.ContentLength,
+		ContentType: res.Header.Get("Content-Type"),
+		CopyStatus:  res.Header.Get("x-ms-copy-status"),
+	}
+	properties.LastModified, _ = http.ParseTime(res.Header.Get("Last-Modified"))
+
+	return properties, nil
+}
+
+// GetBlob reads the whole blob
+func (c *Client) GetBlob(container, blob string) (io.ReadCloser, error) {
+	res, err := c.do(http.MethodGet, c.blobURL(container, blob, nil), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Body, nil
+}
+
+// GetBlobRange reads count bytes of the blob, starting at offset. A count of 0 reads until the end.
+func (c *Client) GetBlobRange(container, blob string, offset, count int64) (io.ReadCloser, error) {
+	headers := http.Header{}
+	if count > 0 {
+		headers.Set("x-ms-range", fmt.Sprintf("bytes=%d-%d", offset, offset+count-1))
+	} else {
+		headers.Set("x-ms-range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	res, err := c.do(http.MethodGet, c.blobURL(container, blob, nil), headers, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Body, nil
+}
+
+// ListBlobs returns the blobs whose name starts with prefix.
+// If delimiter is set, blobs in "sub directories" are not returned, and the names of the sub directories are returned in prefixes instead.
+func (c *Client) ListBlobs(container, prefix, delimiter string) (blobs []*Blob, prefixes []string, err error) {
+	marker := ""
+	for {
+		query := url.Values{}
+		query.Set("restype", "container")
+		query.Set("comp", "list")
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if delimiter != "" {
+			query.Set("delimiter", delimiter)
+		}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+
+		res, err := c.do(http.MethodGet, c.blobURL(container, "", query), nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		result := &struct {
+			Blobs []struct {
+				Name       string `xml:"Name"`
+				Properties struct {
+					LastModified  string `xml:"Last-Modified"`
+					ContentLength int64  `xml:"Content-Length"`
+					ContentType   string `xml:"Content-Type"`
+				} `xml:"Properties"`
+			} `xml:"Blobs>Blob"`
+			Prefixes []struct {
+				Name string `xml:"Name"`
+			} `xml:"Blobs>BlobPrefix"`
+			NextMarker string `xml:"NextMarker"`
+		}{}
+		err = xml.NewDecoder(res.Body).Decode(result)
+		res.Body.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, item := range result.Blobs {
+			blob := &Blob{
+				Name:        item.Name,
+				Size:        item.Properties.ContentLength,
+				ContentType: item.Properties.ContentType,
+			}
+			blob.LastModified, _ = http.ParseTime(item.Properties.LastModified)
+			blobs = append(blobs, blob)
+		}
+		for _, item := range result.Prefixes {
+			prefixes = append(prefixes, item.Name)
+		}
+
+		if result.NextMarker == "" {
+			return blobs, prefixes, nil
+		}
+		marker = result.NextMarker
+	}
+}
+
+// PutBlob creates or replaces a block blob with data. contentType is optional.
+func (c *Client) PutBlob(container, blob, contentType string, data []byte) error {
+	headers := http.Header{}
+	headers.Set("x-ms-blob-type", "BlockBlob")
+	if contentType != "" {
+		headers.Set("x-ms-blob-content-type", contentType)
+	}
+
+	res, err := c.do(http.MethodPut, c.blobURL(container, blob, nil), headers, data)
+	if err != nil {
+		return err
+	}
+
+	return res.Body.Close()
+}
+
+// DeleteBlob deletes the blob
+func (c *Client) DeleteBlob(container, blob string) error {
+	res, err := c.do(http.MethodDelete, c.blobURL(container, blob, nil), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return res.Body.Close()
+}
+
+// CopyBlob copies source to destination within the container and waits for the copy to complete.
+// If an asynchronous copy takes longer than the client's copy timeout, it is aborted and an error is returned.
+func (c *Client) CopyBlob(container, source, destination string) error {
+	headers := http.Header{}
+	headers.Set("x-ms-copy-source", c.blobURL(container, source, nil).String())
+
+	res, err := c.do(http.MethodPut, c.blobURL(container, destination, nil), headers, nil)
+	if err != nil {
+		return err
+	}
+	status := res.Header.Get("x-ms-copy-status")
+	copyID := res.Header.Get("x-ms-copy-id")
+	res.Body.Close()
+
+	// Copies within an account are usually done right away, but large blobs may be copied asynchronously
+	deadline := time.Now().Add(c.copyTimeout)
+	for status == "pending" {
+		if time.Now().After(deadline) {
+			if err := c.abortCopyBlob(container, destination, copyID); err != nil {
+				return fmt.Errorf("azure blob storage: copy of %v did not finish within %v and could not be aborted: %v", source, c.copyTimeout, err)
+			}
+			return fmt.Errorf("azure blob storage: copy of %v did not finish within %v", source, c.copyTimeout)
+		}
+
+		time.Sleep(time.Second)
+		properties, err := c.GetProperties(container, destination)
+		if err != nil {
+			return err
+		}
+		status = properties.CopyStatus
+	}
+	if status != "" && status != "success" {
+		return fmt.Errorf("azure blob storage: copy of %v finished with status %v", source, status)
+	}
+
+	return nil
+}
+
+// abortCopyBlob aborts the pending copy to blob, which leaves blob empty
+func (c *Client) abortCopyBlob(container, blob, copyID string) error {
+	query := url.Values{}
+	query.Set("comp", "copy")
+	query.Set("copyid", copyID)
+	headers := http.Header{}
+	headers.Set("x-ms-copy-action", "abort")
+
+	res, err := c.do(http.MethodPut, c.blobURL(container, blob, query), headers, nil)
+	if err != nil {
+		return err
+	}
+
+	return res.Body.Close()
+}
+
+// SignedURL creates a service SAS URL that grants permissions to the blob until expires.
+// permissions is a combination of r (read), c (create) and w (write).
+// See https://docs.microsoft.com/en-us/rest/api/storageservices/create-service-sas
+func (c *Client) SignedURL(container, blob, permissions string, expires time.Time) string {
+	expiry := expires.UTC().Format("2006-01-02T15:04:05Z")
+	stringToSign := strings.Join([]string{
+		permissions,
+		"", // start
+		expiry,
+		"/blob/" + c.account + "/" + container + "/" + blob,
+		"", // identifier
+		"", // ip
+		"", // protocol
+		apiVersion,
+		"b", // resource
+		"",  // snapshot time
+		"",  // cache control
+		"",  // content disposition
+		"",  // content encoding
+		"",  // content language
+		"",  // content type
+	}, "\n")
+
+	query := url.Values{}
+	query.Set("sv", apiVersion)
+	query.Set("sr", "b")
+	query.Set("sp", permissions)
+	query.Set("se", expiry)
+	query.Set("sig", c.sign(stringToSign))
+
+	return c.blobURL(container, blob, query).String()
+}
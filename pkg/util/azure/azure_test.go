@@ -0,0 +1,190 @@
+package azure
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// azuriteAccountKey is the well known key of the devstoreaccount1 account of the Azurite emulator
+const azuriteAccountKey = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+
+// newAzuriteClient returns a client for the Azurite emulator at AZURITE_ENDPOINT, for example http://127.0.0.1:10000/devstoreaccount1,
+// and a new container in it. The test is skipped if AZURITE_ENDPOINT is not set.
+func newAzuriteClient(t *testing.T) (client *Client, container string) {
+	endpoint := os.Getenv("AZURITE_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("AZURITE_ENDPOINT is not set")
+	}
+
+	client, err := NewClient(Config{
+		Account:    "devstoreaccount1",
+		AccountKey: azuriteAccountKey,
+		Endpoint:   endpoint,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	container = fmt.Sprintf("test-%v", time.Now().UnixNano())
+	query := url.Values{"restype": {"container"}}
+	res, err := client.do(http.MethodPut, client.blobURL(container, "", query), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	t.Cleanup(func() {
+		res, err := client.do(http.MethodDelete, client.blobURL(container, "", query), nil, nil)
+		if err == nil {
+			res.Body.Close()
+		}
+	})
+
+	return client, container
+}
+
+// TestClient_Azurite makes sure shared key requests, listing and SAS URLs are accepted by the emulator
+func TestClient_Azurite(t *testing.T) {
+	client, container := newAzuriteClient(t)
+
+	for _, blob := range []string{"datasets/train.csv", "datasets/old/a.csv", "model name.h5"} {
+		assert.Nil(t, client.PutBlob(container, blob, "text/csv", []byte(blob)))
+	}
+
+	blobs, prefixes, err := client.ListBlobs(container, "datasets/", "/")
+	assert.Nil(t, err)
+	assert.Len(t, blobs, 1)
+	assert.Equal(t, "datasets/train.csv", blobs[0].Name)
+	assert.Equal(t, int64(len("datasets/train.csv")), blobs[0].Size)
+	assert.Equal(t, []string{"datasets/old/"}, prefixes)
+
+	blobs, prefixes, err = client.ListBlobs(container, "", "")
+	assert.Nil(t, err)
+	assert.Len(t, blobs, 3)
+	assert.Empty(t, prefixes)
+
+	properties, err := client.GetProperties(container, "model name.h5")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len("model name.h5")), properties.Size)
+
+	_, err = client.GetProperties(container, "missing.csv")
+	assert.True(t, IsNotFound(err))
+
+	assert.Nil(t, client.CopyBlob(container, "datasets/train.csv", "backup/train.csv"))
+	assert.Nil(t, client.DeleteBlob(container, "datasets/train.csv"))
+	_, err = client.GetProperties(container, "datasets/train.csv")
+	assert.True(t, IsNotFound(err))
+
+	// Read with a SAS URL
+	res, err := http.Get(client.SignedURL(container, "backup/train.csv", "r", time.Now().Add(time.Minute)))
+	assert.Nil(t, err)
+	data, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "datasets/train.csv", string(data))
+
+	// Upload with a SAS URL
+	req, err := http.NewRequest(http.MethodPut, client.SignedURL(container, "uploads/data.csv", "cw", time.Now().Add(time.Minute)), strings.NewReader("a,b"))
+	assert.Nil(t, err)
+	req.Header.Set("x-ms-blob-type", "BlockBlob")
+	res, err = http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+	// A read only SAS URL can not upload
+	req, err = http.NewRequest(http.MethodPut, client.SignedURL(container, "uploads/other.csv", "r", time.Now().Add(time.Minute)), strings.NewReader("a,b"))
+	assert.Nil(t, err)
+	req.Header.Set("x-ms-blob-type", "BlockBlob")
+	res, err = http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+}
+
+// TestClient_ListBlobs makes sure every page of a listing is read and requests are authorized
+func TestClient_ListBlobs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "SharedKey devstoreaccount1:"))
+		assert.NotEmpty(t, r.Header.Get("x-ms-date"))
+		assert.Equal(t, "/devstoreaccount1/onepanel", r.URL.Path)
+		assert.Equal(t, "list", r.URL.Query().Get("comp"))
+		assert.Equal(t, "datasets/", r.URL.Query().Get("prefix"))
+
+		if r.URL.Query().Get("marker") == "" {
+			fmt.Fprint(w, `<EnumerationResults><Blobs>
+<Blob><Name>datasets/a.csv</Name><Properties><Content-Length>3</Content-Length><Content-Type>text/csv</Content-Type><Last-Modified>Mon, 07 Sep 2020 10:00:00 GMT</Last-Modified></Properties></Blob>
+<BlobPrefix><Name>datasets/old/</Name></BlobPrefix>
+</Blobs><NextMarker>page-2</NextMarker></EnumerationResults>`)
+			return
+		}
+
+		assert.Equal(t, "page-2", r.URL.Query().Get("marker"))
+		fmt.Fprint(w, `<EnumerationResults><Blobs>
+<Blob><Name>datasets/b.csv</Name><Properties><Content-Length>5</Content-Length></Properties></Blob>
+</Blobs><NextMarker /></EnumerationResults>`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(Config{
+		Account:    "devstoreaccount1",
+		AccountKey: azuriteAccountKey,
+		Endpoint:   server.URL + "/devstoreaccount1",
+	})
+	assert.Nil(t, err)
+
+	blobs, prefixes, err := client.ListBlobs("onepanel", "datasets/", "/")
+	assert.Nil(t, err)
+	assert.Len(t, blobs, 2)
+	assert.Equal(t, "datasets/a.csv", blobs[0].Name)
+	assert.Equal(t, int64(3), blobs[0].Size)
+	assert.Equal(t, "text/csv", blobs[0].ContentType)
+	assert.Equal(t, time.Date(2020, 9, 7, 10, 0, 0, 0, time.UTC), blobs[0].LastModified.UTC())
+	assert.Equal(t, "datasets/b.csv", blobs[1].Name)
+	assert.Equal(t, []string{"datasets/old/"}, prefixes)
+}
+
+// TestClient_CopyBlob_Timeout makes sure a copy that stays pending is aborted once the copy timeout passes
+func TestClient_CopyBlob_Timeout(t *testing.T) {
+	aborted := false
+	var lock sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if r.Header.Get("x-ms-copy-action") == "abort" {
+			assert.Equal(t, "copy-1", r.URL.Query().Get("copyid"))
+			aborted = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		w.Header().Set("x-ms-copy-id", "copy-1")
+		w.Header().Set("x-ms-copy-status", "pending")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(Config{
+		Account:     "devstoreaccount1",
+		AccountKey:  azuriteAccountKey,
+		Endpoint:    server.URL + "/devstoreaccount1",
+		CopyTimeout: time.Millisecond,
+	})
+	assert.Nil(t, err)
+
+	err = client.CopyBlob("onepanel", "large.bin", "copy.bin")
+	assert.NotNil(t, err)
+	lock.Lock()
+	assert.True(t, aborted)
+	lock.Unlock()
+}
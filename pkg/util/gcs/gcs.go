@@ -7,6 +7,7 @@ import (
 	"golang.org/x/oauth2/google"
 	"google.golang.org/api/option"
 	"io"
+	"time"
 )
 
 // Client is a struct used for accessing Google Cloud Storage.
@@ -55,3 +56,21 @@ func (c *Client) GetObject(bucket, key string) (stream io.ReadCloser, err error)
 
 	return
 }
+
+// SignedURL creates a V4 signed URL that allows method requests to the object until expires, signed with the service account's private key.
+// If contentType is set, requests must send the same Content-Type header.
+func SignedURL(serviceAccountJSON, bucket, key, method, contentType string, expires time.Time) (string, error) {
+	jwtConfig, err := google.JWTConfigFromJSON([]byte(serviceAccountJSON))
+	if err != nil {
+		return "", err
+	}
+
+	return storage.SignedURL(bucket, key, &storage.SignedURLOptions{
+		GoogleAccessID: jwtConfig.Email,
+		PrivateKey:     jwtConfig.PrivateKey,
+		Method:         method,
+		Expires:        expires,
+		ContentType:    contentType,
+		Scheme:         storage.SigningSchemeV4,
+	})
+}
@@ -1,13 +1,53 @@
 package pagination
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"github.com/Masterminds/squirrel"
 	"math"
+	"time"
 )
 
+// ErrInvalidToken is returned when a continuation token can not be decoded
+var ErrInvalidToken = errors.New("invalid page token")
+
 type PaginationRequest struct {
 	Page     uint64
 	PageSize uint64
+	// Cursor is the position after which results continue. If set, keyset pagination is used instead of LIMIT/OFFSET.
+	Cursor *Cursor
+}
+
+// Cursor is the position of the last item of a page, ordered by creation time and then id, most recent first.
+type Cursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        uint64    `json:"i"`
+}
+
+// Token encodes the cursor as an opaque continuation token
+func (c *Cursor) Token() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// CursorFromToken decodes a continuation token created by Cursor.Token
+func CursorFromToken(token string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	cursor := &Cursor{}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	return cursor, nil
 }
 
 func NewRequest(page, pageSize int32) PaginationRequest {
@@ -25,6 +65,23 @@ func NewRequest(page, pageSize int32) PaginationRequest {
 	}
 }
 
+// NewRequestFromToken creates a PaginationRequest for either mode.
+// If token is empty, page based pagination is used. Otherwise the token is decoded into a Cursor.
+func NewRequestFromToken(page, pageSize int32, token string) (PaginationRequest, error) {
+	pr := NewRequest(page, pageSize)
+	if token == "" {
+		return pr, nil
+	}
+
+	cursor, err := CursorFromToken(token)
+	if err != nil {
+		return pr, err
+	}
+	pr.Cursor = cursor
+
+	return pr, nil
+}
+
 // Start creates a new PaginationRequest that starts at the first page.
 // You can provide an optional pageSize argument. If none is provided, 15 is used.
 // All arguments apart from the first one are ignored.
@@ -48,17 +105,44 @@ func (pr *PaginationRequest) CalculatePages(count int) int32 {
 	return int32(math.Ceil(float64(count) / float64(pr.PageSize)))
 }
 
-func (pr *PaginationRequest) ApplyToSelect(sb *squirrel.SelectBuilder) *squirrel.SelectBuilder {
+// ApplyToSelect limits the select to a single page.
+//
+// keyset is the optional pair of created at and id columns, e.g. "we.created_at", "we.id".
+// If the request has a Cursor and a keyset is provided, rows after the cursor are selected instead of using an offset.
+// The select is expected to be ordered by the keyset columns, most recent first.
+func (pr *PaginationRequest) ApplyToSelect(sb *squirrel.SelectBuilder, keyset ...string) *squirrel.SelectBuilder {
 	if pr == nil {
 		return sb
 	}
 
+	if pr.Cursor != nil && len(keyset) == 2 {
+		result := sb.Where("("+keyset[0]+", "+keyset[1]+") < (?, ?)", pr.Cursor.CreatedAt.UTC(), pr.Cursor.ID).
+			Limit(pr.PageSize)
+
+		return &result
+	}
+
 	result := sb.Limit(pr.PageSize).
 		Offset(pr.Offset())
 
 	return &result
 }
 
+// NextToken returns the continuation token for the page after the one that ended with the given item.
+// If the page was not full there are no more results and an empty string is returned.
+func (pr *PaginationRequest) NextToken(itemCount int, lastCreatedAt time.Time, lastID uint64) string {
+	if pr == nil || uint64(itemCount) < pr.PageSize {
+		return ""
+	}
+
+	cursor := &Cursor{
+		CreatedAt: lastCreatedAt.UTC(),
+		ID:        lastID,
+	}
+
+	return cursor.Token()
+}
+
 // Advance returns a new pagination request with the page incremented by 1
 func (pr *PaginationRequest) Advance() *PaginationRequest {
 	return &PaginationRequest{
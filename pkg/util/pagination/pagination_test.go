@@ -0,0 +1,76 @@
+package pagination
+
+import (
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_Cursor_TokenRoundTrip(t *testing.T) {
+	cursor := &Cursor{
+		CreatedAt: time.Date(2020, 8, 26, 18, 59, 26, 123456000, time.UTC),
+		ID:        42,
+	}
+
+	result, err := CursorFromToken(cursor.Token())
+	assert.Nil(t, err)
+	assert.True(t, cursor.CreatedAt.Equal(result.CreatedAt))
+	assert.Equal(t, cursor.ID, result.ID)
+}
+
+func Test_CursorFromToken_Invalid(t *testing.T) {
+	_, err := CursorFromToken("not a token")
+	assert.Equal(t, ErrInvalidToken, err)
+}
+
+func Test_NewRequestFromToken_Empty(t *testing.T) {
+	pr, err := NewRequestFromToken(2, 10, "")
+	assert.Nil(t, err)
+	assert.Nil(t, pr.Cursor)
+	assert.Equal(t, uint64(2), pr.Page)
+	assert.Equal(t, uint64(10), pr.PageSize)
+}
+
+func Test_ApplyToSelect_Offset(t *testing.T) {
+	pr := NewRequest(3, 10)
+	sb := squirrel.Select("*").From("workflow_executions we")
+
+	query, _, err := pr.ApplyToSelect(&sb, "we.created_at", "we.id").ToSql()
+	assert.Nil(t, err)
+	assert.Equal(t, "SELECT * FROM workflow_executions we LIMIT 10 OFFSET 20", query)
+}
+
+func Test_ApplyToSelect_Keyset(t *testing.T) {
+	cursor := &Cursor{CreatedAt: time.Now(), ID: 7}
+	pr, err := NewRequestFromToken(3, 10, cursor.Token())
+	assert.Nil(t, err)
+	sb := squirrel.Select("*").From("workflow_executions we")
+
+	query, args, err := pr.ApplyToSelect(&sb, "we.created_at", "we.id").ToSql()
+	assert.Nil(t, err)
+	assert.Equal(t, "SELECT * FROM workflow_executions we WHERE (we.created_at, we.id) < (?, ?) LIMIT 10", query)
+	assert.Equal(t, uint64(7), args[1])
+}
+
+func Test_ApplyToSelect_KeysetWithoutColumns(t *testing.T) {
+	cursor := &Cursor{CreatedAt: time.Now(), ID: 7}
+	pr, _ := NewRequestFromToken(1, 10, cursor.Token())
+	sb := squirrel.Select("*").From("namespaces")
+
+	query, _, err := pr.ApplyToSelect(&sb).ToSql()
+	assert.Nil(t, err)
+	assert.Equal(t, "SELECT * FROM namespaces LIMIT 10 OFFSET 0", query)
+}
+
+func Test_NextToken(t *testing.T) {
+	pr := NewRequest(1, 2)
+	createdAt := time.Now()
+
+	assert.Equal(t, "", pr.NextToken(1, createdAt, 5))
+
+	token := pr.NextToken(2, createdAt, 5)
+	cursor, err := CursorFromToken(token)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), cursor.ID)
+}
@@ -0,0 +1,83 @@
+package prometheus
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ErrNoData is returned when a query has no samples, for example because the series it selects do not exist yet
+var ErrNoData = errors.New("query returned no data")
+
+// Client queries the HTTP API of a Prometheus server
+type Client struct {
+	URL        string
+	HTTPClient *http.Client
+}
+
+// queryResponse is the response of /api/v1/query for an instant vector
+type queryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			// Value is the pair of the sample's unix time and its value as a string
+			Value []interface{} `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// NewClient creates a client for the Prometheus server at url, such as http://prometheus.istio-system:9090
+func NewClient(url string) *Client {
+	return &Client{
+		URL: url,
+		HTTPClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// QuerySum evaluates an instant vector query and returns the sum of its samples, or ErrNoData if there are none
+func (c *Client) QuerySum(query string) (sum float64, err error) {
+	resp, err := c.HTTPClient.Get(c.URL + "/api/v1/query?" + url.Values{"query": {query}}.Encode())
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	result := &queryResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return 0, fmt.Errorf("unable to decode response with status %v: %v", resp.StatusCode, err)
+	}
+	if result.Status != "success" {
+		return 0, fmt.Errorf("query failed: %v", result.Error)
+	}
+	if result.Data.ResultType != "vector" {
+		return 0, fmt.Errorf("query returned a %v instead of a vector", result.Data.ResultType)
+	}
+	if len(result.Data.Result) == 0 {
+		return 0, ErrNoData
+	}
+
+	for _, sample := range result.Data.Result {
+		if len(sample.Value) != 2 {
+			return 0, fmt.Errorf("invalid sample %v", sample.Value)
+		}
+		value, ok := sample.Value[1].(string)
+		if !ok {
+			return 0, fmt.Errorf("invalid sample value %v", sample.Value[1])
+		}
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, err
+		}
+		sum += number
+	}
+
+	return
+}
@@ -12,6 +12,8 @@ type Client struct {
 
 type GetObjectOptions = minio.GetObjectOptions
 
+type StatObjectOptions = minio.StatObjectOptions
+
 type Config struct {
 	AccessKey string
 	SecretKey string
@@ -0,0 +1,132 @@
+package v1
+
+import (
+	"github.com/onepanelio/core/pkg/util/ptr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// volumeSnapshotAPIGroup is the API group of the CSI snapshot resources
+const volumeSnapshotAPIGroup = "snapshot.storage.k8s.io"
+
+var (
+	volumeSnapshotResource = schema.GroupVersionResource{
+		Group:    volumeSnapshotAPIGroup,
+		Version:  "v1beta1",
+		Resource: "volumesnapshots",
+	}
+	volumeSnapshotClassResource = schema.GroupVersionResource{
+		Group:    volumeSnapshotAPIGroup,
+		Version:  "v1beta1",
+		Resource: "volumesnapshotclasses",
+	}
+)
+
+// volumeSnapshotClassForDriver returns the name of the VolumeSnapshotClass of the CSI driver, preferring the default class.
+// It returns "" if the driver has no class.
+func volumeSnapshotClassForDriver(classes []unstructured.Unstructured, driver string) (name string) {
+	for _, class := range classes {
+		classDriver, _, _ := unstructured.NestedString(class.Object, "driver")
+		if classDriver != driver {
+			continue
+		}
+
+		if class.GetAnnotations()["snapshot.storage.kubernetes.io/is-default-class"] == "true" {
+			return class.GetName()
+		}
+		if name == "" {
+			name = class.GetName()
+		}
+	}
+
+	return
+}
+
+// getVolumeSnapshotClassName returns the VolumeSnapshotClass to snapshot volumes of the storage class with.
+// It returns "" if they can not be snapshotted, because the storage class is not provisioned by a CSI driver with a
+// VolumeSnapshotClass or the snapshot resources are not installed.
+func (c *Client) getVolumeSnapshotClassName(storageClassName *string) (string, error) {
+	if storageClassName == nil || *storageClassName == "" {
+		return "", nil
+	}
+
+	storageClass, err := c.StorageV1().StorageClasses().Get(*storageClassName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	classes, err := c.Dynamic().Resource(volumeSnapshotClassResource).List(metav1.ListOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return volumeSnapshotClassForDriver(classes.Items, storageClass.Provisioner), nil
+}
+
+// createVolumeSnapshot creates a VolumeSnapshot of the PersistentVolumeClaim with the VolumeSnapshotClass
+func (c *Client) createVolumeSnapshot(namespace, name, className, claimName string, labels map[string]string) error {
+	snapshot := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": volumeSnapshotResource.GroupVersion().String(),
+			"kind":       "VolumeSnapshot",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": namespace,
+			},
+			"spec": map[string]interface{}{
+				"volumeSnapshotClassName": className,
+				"source": map[string]interface{}{
+					"persistentVolumeClaimName": claimName,
+				},
+			},
+		},
+	}
+	snapshot.SetLabels(labels)
+
+	_, err := c.Dynamic().Resource(volumeSnapshotResource).Namespace(namespace).Create(snapshot, metav1.CreateOptions{})
+
+	return err
+}
+
+// volumeSnapshotDataSource returns the data source of a PersistentVolumeClaim that is restored from the VolumeSnapshot
+func volumeSnapshotDataSource(name string) *corev1.TypedLocalObjectReference {
+	return &corev1.TypedLocalObjectReference{
+		APIGroup: ptr.String(volumeSnapshotAPIGroup),
+		Kind:     "VolumeSnapshot",
+		Name:     name,
+	}
+}
+
+// volumeSnapshotStatus returns whether a volume can be restored from the VolumeSnapshot, and why it failed if it did
+func volumeSnapshotStatus(snapshot *unstructured.Unstructured) (ready bool, message string) {
+	ready, _, _ = unstructured.NestedBool(snapshot.Object, "status", "readyToUse")
+	message, _, _ = unstructured.NestedString(snapshot.Object, "status", "error", "message")
+
+	return
+}
+
+// listVolumeSnapshots returns the VolumeSnapshots selected by the label selector by name
+func (c *Client) listVolumeSnapshots(namespace, labelSelector string) (map[string]*unstructured.Unstructured, error) {
+	snapshotList, err := c.Dynamic().Resource(volumeSnapshotResource).Namespace(namespace).List(metav1.ListOptions{
+		LabelSelector: labelSelector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make(map[string]*unstructured.Unstructured)
+	for i := range snapshotList.Items {
+		snapshots[snapshotList.Items[i].GetName()] = &snapshotList.Items[i]
+	}
+
+	return snapshots, nil
+}
@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	"testing"
+)
+
+func Test_volumeSnapshotClassForDriver(t *testing.T) {
+	classes := []unstructured.Unstructured{
+		*newTestVolumeSnapshotClass("ebs", "ebs.csi.aws.com", false),
+		*newTestVolumeSnapshotClass("pd", "pd.csi.storage.gke.io", false),
+		*newTestVolumeSnapshotClass("pd-default", "pd.csi.storage.gke.io", true),
+	}
+
+	assert.Equal(t, "ebs", volumeSnapshotClassForDriver(classes, "ebs.csi.aws.com"))
+	assert.Equal(t, "pd-default", volumeSnapshotClassForDriver(classes, "pd.csi.storage.gke.io"))
+	assert.Empty(t, volumeSnapshotClassForDriver(classes, "kubernetes.io/aws-ebs"))
+}
+
+// Test_loadWorkspaceSnapshotStatus makes sure a snapshot is ready once every volume snapshot is, and has the error of a failed one
+func Test_loadWorkspaceSnapshotStatus(t *testing.T) {
+	c := &Client{
+		dynamic: dynamicFake.NewSimpleDynamicClient(runtime.NewScheme(),
+			newTestVolumeSnapshot("data-jupyterlab-0-monday", "jupyterlab", map[string]interface{}{"readyToUse": true}),
+			newTestVolumeSnapshot("home-jupyterlab-0-monday", "jupyterlab", map[string]interface{}{"readyToUse": true}),
+			newTestVolumeSnapshot("data-jupyterlab-0-tuesday", "jupyterlab", map[string]interface{}{"readyToUse": true}),
+			newTestVolumeSnapshot("home-jupyterlab-0-tuesday", "jupyterlab", map[string]interface{}{
+				"readyToUse": false,
+				"error":      map[string]interface{}{"message": "quota exceeded"},
+			}),
+		),
+	}
+
+	monday := &WorkspaceSnapshot{VolumeSnapshots: map[string]string{"data": "data-jupyterlab-0-monday", "home": "home-jupyterlab-0-monday"}}
+	tuesday := &WorkspaceSnapshot{VolumeSnapshots: map[string]string{"data": "data-jupyterlab-0-tuesday", "home": "home-jupyterlab-0-tuesday"}}
+	wednesday := &WorkspaceSnapshot{VolumeSnapshots: map[string]string{"data": "data-jupyterlab-0-wednesday"}}
+
+	err := c.loadWorkspaceSnapshotStatus("onepanel", "jupyterlab", monday, tuesday, wednesday)
+	assert.Nil(t, err)
+
+	assert.True(t, monday.Ready)
+	assert.Empty(t, monday.Error)
+	assert.False(t, tuesday.Ready)
+	assert.Equal(t, "quota exceeded", tuesday.Error)
+	assert.False(t, wednesday.Ready)
+	assert.NotEmpty(t, wednesday.Error)
+}
@@ -2,19 +2,16 @@ package v1
 
 import (
 	"bufio"
-	"cloud.google.com/go/storage"
 	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
 	sq "github.com/Masterminds/squirrel"
-	"github.com/onepanelio/core/pkg/util/gcs"
 	"github.com/onepanelio/core/pkg/util/label"
 	"github.com/onepanelio/core/pkg/util/pagination"
 	"github.com/onepanelio/core/pkg/util/ptr"
 	"github.com/onepanelio/core/pkg/util/types"
 	uid2 "github.com/onepanelio/core/pkg/util/uid"
-	"golang.org/x/net/context"
 	"gopkg.in/yaml.v2"
 	"io"
 	"io/ioutil"
@@ -22,6 +19,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
@@ -32,19 +30,23 @@ import (
 	argojson "github.com/argoproj/pkg/json"
 	"github.com/onepanelio/core/pkg/util"
 	"github.com/onepanelio/core/pkg/util/env"
-	"github.com/onepanelio/core/pkg/util/s3"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"k8s.io/client-go/tools/cache"
 )
 
 var (
 	readEndOffset                   = env.GetEnv("ARTIFACT_RERPOSITORY_OBJECT_RANGE", "-102400")
+	batchConcurrency                = env.GetEnv("WORKFLOW_EXECUTION_BATCH_CONCURRENCY", "10")
 	workflowTemplateUIDLabelKey     = "onepanel.io/workflow-template-uid"
 	workflowTemplateVersionLabelKey = "onepanel.io/workflow-template-version"
+	// artifactRepositoryAnnotationPrefix is followed by an artifact's name to select the artifact repository it is stored in
+	artifactRepositoryAnnotationPrefix = "artifact-repository.onepanel.io/"
 )
 
 func typeWorkflow(wf *wfv1.Workflow) (workflow *WorkflowExecution) {
@@ -102,11 +104,19 @@ func getWorkflowsFromWorkflowTemplate(wt *WorkflowTemplate) (wfs []wfv1.Workflow
 	return
 }
 
-// appendArtifactRepositoryConfigIfMissing appends default artifact repository config to artifacts that have a key.
+// injectArtifactRepositoryConfig appends the artifact repository config of provider to artifacts that have a key.
 // Artifacts that contain anything other than key are skipped.
-func injectArtifactRepositoryConfig(artifact *wfv1.Artifact, namespaceConfig *NamespaceConfig) {
+// It is an InvalidArgument error if the artifact needs the config of a different type of repository than provider.
+func injectArtifactRepositoryConfig(artifact *wfv1.Artifact, provider *ArtifactRepositoryProvider) error {
+	if artifact.S3 != nil && artifact.S3.Key != "" && artifact.S3.Bucket == "" && provider.S3 == nil {
+		return util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Artifact '%v' is an S3 artifact, but its artifact repository is not S3.", artifact.Name))
+	}
+	if artifact.GCS != nil && provider.GCS == nil {
+		return util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Artifact '%v' is a GCS artifact, but its artifact repository is not GCS.", artifact.Name))
+	}
+
 	if artifact.S3 != nil && artifact.S3.Key != "" && artifact.S3.Bucket == "" {
-		s3Config := namespaceConfig.ArtifactRepository.S3
+		s3Config := provider.S3
 		artifact.S3.Endpoint = s3Config.Endpoint
 		artifact.S3.Bucket = s3Config.Bucket
 		artifact.S3.Region = s3Config.Region
@@ -125,18 +135,45 @@ func injectArtifactRepositoryConfig(artifact *wfv1.Artifact, namespaceConfig *Na
 		}
 	}
 
-	if artifact.GCS != nil && namespaceConfig.ArtifactRepository.GCS != nil {
-		gcsConfig := namespaceConfig.ArtifactRepository.GCS
+	if artifact.GCS != nil {
+		gcsConfig := provider.GCS
 		artifact.GCS.Bucket = gcsConfig.Bucket
 		artifact.GCS.Key = gcsConfig.KeyFormat
 		artifact.GCS.ServiceAccountKeySecret.Name = "onepanel"
 		artifact.GCS.ServiceAccountKeySecret.Key = "artifactRepositoryGCSServiceAccountKey"
+		if gcsConfig.ServiceAccountKeySecret.Key != "" {
+			artifact.GCS.ServiceAccountKeySecret.Key = gcsConfig.ServiceAccountKeySecret.Key
+		}
 	}
 
 	// Default to no compression for artifacts
 	artifact.Archive = &wfv1.ArchiveStrategy{
 		None: &wfv1.NoneStrategy{},
 	}
+
+	return nil
+}
+
+// artifactRepositoryName returns the name of the artifact repository the template's artifact is stored in.
+// It is set with the annotation artifact-repository.onepanel.io/<artifact name>, the default repository is used if it is missing.
+func artifactRepositoryName(template *wfv1.Template, artifactName string) string {
+	return template.Metadata.Annotations[artifactRepositoryAnnotationPrefix+artifactName]
+}
+
+// workflowArtifactRepository returns the artifact repository the template's artifact is stored in.
+// Argo can only read and write S3 and GCS artifacts, so naming any other repository is an InvalidArgument error.
+func workflowArtifactRepository(namespaceConfig *NamespaceConfig, template *wfv1.Template, artifactName string) (*ArtifactRepositoryProvider, error) {
+	name := artifactRepositoryName(template, artifactName)
+	provider, err := namespaceConfig.GetArtifactRepository(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != "" && !provider.SupportsWorkflowArtifacts() {
+		return nil, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Artifact repository '%v' of artifact '%v' can not be used by workflows, only S3 and GCS repositories can.", name, artifactName))
+	}
+
+	return provider, nil
 }
 
 // injectContainerResourceQuotas adds resource requests and limits if they exist
@@ -274,12 +311,24 @@ func (c *Client) injectAutomatedFields(namespace string, wf *wfv1.Workflow, opts
 
 			// Extend artifact credentials if only key is provided
 			for j, artifact := range template.Outputs.Artifacts {
-				injectArtifactRepositoryConfig(&artifact, namespaceConfig)
+				provider, err := workflowArtifactRepository(namespaceConfig, template, artifact.Name)
+				if err != nil {
+					return err
+				}
+				if err := injectArtifactRepositoryConfig(&artifact, provider); err != nil {
+					return err
+				}
 				template.Outputs.Artifacts[j] = artifact
 			}
 
 			for j, artifact := range template.Inputs.Artifacts {
-				injectArtifactRepositoryConfig(&artifact, namespaceConfig)
+				provider, err := workflowArtifactRepository(namespaceConfig, template, artifact.Name)
+				if err != nil {
+					return err
+				}
+				if err := injectArtifactRepositoryConfig(&artifact, provider); err != nil {
+					return err
+				}
 				template.Inputs.Artifacts[j] = artifact
 			}
 		}
@@ -440,7 +489,29 @@ func (c *Client) ValidateWorkflowExecution(namespace string, manifest []byte) (e
 // CreateWorkflowExecution creates an argo workflow execution and related resources.
 // If workflow.Name is set, it is used instead of a generated name.
 // If there is a parameter named "workflow-execution-name" in workflow.Parameters, it is set as the name.
+// Executions of system templates, such as workspace actions, are not limited by the namespace's quota.
 func (c *Client) CreateWorkflowExecution(namespace string, workflow *WorkflowExecution, workflowTemplate *WorkflowTemplate) (*WorkflowExecution, error) {
+	return c.createLabeledWorkflowExecution(namespace, workflow, workflowTemplate, nil)
+}
+
+// createLabeledWorkflowExecution is CreateWorkflowExecution with systemLabels, onepanel.io labels such as the sweep labels.
+// They are only set by the server, labels of workflow with the onepanel.io prefix are dropped.
+func (c *Client) createLabeledWorkflowExecution(namespace string, workflow *WorkflowExecution, workflowTemplate *WorkflowTemplate, systemLabels map[string]string) (*WorkflowExecution, error) {
+	if workflowTemplate.IsSystem {
+		return c.createWorkflowExecution(namespace, workflow, workflowTemplate, systemLabels)
+	}
+
+	var created *WorkflowExecution
+	err := c.withQuota(namespace, 0, 1, parametersNodePool(workflow.Parameters), func() (err error) {
+		created, err = c.createWorkflowExecution(namespace, workflow, workflowTemplate, systemLabels)
+		return
+	})
+
+	return created, err
+}
+
+// createWorkflowExecution creates the argo workflow execution of createLabeledWorkflowExecution, without checking the quota
+func (c *Client) createWorkflowExecution(namespace string, workflow *WorkflowExecution, workflowTemplate *WorkflowTemplate, systemLabels map[string]string) (*WorkflowExecution, error) {
 	opts := &WorkflowExecutionOptions{
 		Labels:     make(map[string]string),
 		Parameters: workflow.Parameters,
@@ -462,7 +533,19 @@ func (c *Client) CreateWorkflowExecution(namespace string, workflow *WorkflowExe
 
 	opts.Labels[workflowTemplateUIDLabelKey] = workflowTemplate.UID
 	opts.Labels[workflowTemplateVersionLabelKey] = fmt.Sprint(workflowTemplate.Version)
-	label.MergeLabelsPrefix(opts.Labels, workflow.Labels, label.TagPrefix)
+	// Callers can not set labels with the onepanel.io prefix, the server sets them as systemLabels
+	tags := make(types.JSONLabels)
+	for key, value := range workflow.Labels {
+		if !strings.HasPrefix(key, label.OnepanelPrefix) {
+			tags[key] = value
+		}
+	}
+	label.MergeLabelsPrefix(opts.Labels, tags, label.TagPrefix)
+	workflow.Labels = tags
+	for key, value := range systemLabels {
+		opts.Labels[key] = value
+		workflow.Labels[key] = value
+	}
 
 	workflows, err := getWorkflowsFromWorkflowTemplate(workflowTemplate)
 	if err != nil {
@@ -687,11 +770,17 @@ func (c *Client) GetWorkflowExecution(namespace, uid string) (workflow *Workflow
 	return
 }
 
-// ListWorkflowExecutions gets a list of WorkflowExecutions ordered by most recently created first.
-func (c *Client) ListWorkflowExecutions(namespace, workflowTemplateUID, workflowTemplateVersion string, paginator *pagination.PaginationRequest) (workflows []*WorkflowExecution, err error) {
-	sb := workflowExecutionsSelectBuilder(namespace, workflowTemplateUID, workflowTemplateVersion).
-		OrderBy("we.created_at DESC")
-	sb = *paginator.ApplyToSelect(&sb)
+// ListWorkflowExecutions gets a list of WorkflowExecutions matching the filter.
+// If sort is nil, they are ordered by most recently created first.
+// Paginating with a cursor is only supported for the default sort.
+func (c *Client) ListWorkflowExecutions(namespace, workflowTemplateUID, workflowTemplateVersion string, paginator *pagination.PaginationRequest, filter *WorkflowExecutionFilter, sort *WorkflowExecutionSort) (workflows []*WorkflowExecution, err error) {
+	if paginator != nil && paginator.Cursor != nil && !sort.SupportsPageToken() {
+		return nil, util.NewUserError(codes.InvalidArgument, "Page tokens can only be used when ordering by -createdAt.")
+	}
+
+	sb := workflowExecutionsSelectBuilder(namespace, workflowTemplateUID, workflowTemplateVersion, filter).
+		OrderBy(sort.OrderBy()...)
+	sb = *paginator.ApplyToSelect(&sb, "we.created_at", "we.id")
 
 	if err := c.DB.Selectx(&workflows, sb); err != nil {
 		return nil, err
@@ -700,9 +789,9 @@ func (c *Client) ListWorkflowExecutions(namespace, workflowTemplateUID, workflow
 	return
 }
 
-// CountWorkflowExecutions returns the number of workflow executions
-func (c *Client) CountWorkflowExecutions(namespace, workflowTemplateUID, workflowTemplateVersion string) (count int, err error) {
-	err = workflowExecutionsSelectBuilderNoColumns(namespace, workflowTemplateUID, workflowTemplateVersion).
+// CountWorkflowExecutions returns the number of workflow executions matching the filter
+func (c *Client) CountWorkflowExecutions(namespace, workflowTemplateUID, workflowTemplateVersion string, filter *WorkflowExecutionFilter) (count int, err error) {
+	err = workflowExecutionsSelectBuilderNoColumns(namespace, workflowTemplateUID, workflowTemplateVersion, filter).
 		Columns("COUNT(*)").
 		RunWith(c.DB).
 		QueryRow().
@@ -836,9 +925,8 @@ func (c *Client) GetWorkflowExecutionLogs(namespace, uid, podName, containerName
 
 	var (
 		stream    io.ReadCloser
-		s3Client  *s3.Client
-		gcsClient *gcs.Client
 		config    *NamespaceConfig
+		storage   Storage
 		endOffset int
 	)
 
@@ -855,57 +943,33 @@ func (c *Client) GetWorkflowExecutionLogs(namespace, uid, podName, containerName
 			return nil, util.NewUserError(codes.NotFound, "Can't get configuration.")
 		}
 
-		switch {
-		case config.ArtifactRepository.S3 != nil:
-			{
-				s3Client, err = c.GetS3Client(namespace, config.ArtifactRepository.S3)
-				if err != nil {
-					log.WithFields(log.Fields{
-						"Namespace":     namespace,
-						"UID":           uid,
-						"PodName":       podName,
-						"ContainerName": containerName,
-						"Error":         err.Error(),
-					}).Error("Can't connect to S3 storage.")
-					return nil, util.NewUserError(codes.NotFound, "Can't connect to S3 storage.")
-				}
+		storage, err = c.getStorage(namespace, &config.ArtifactRepository)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace":     namespace,
+				"UID":           uid,
+				"PodName":       podName,
+				"ContainerName": containerName,
+				"Error":         err.Error(),
+			}).Error("Can't connect to artifact storage.")
+			return nil, util.NewUserError(codes.NotFound, "Can't connect to artifact storage.")
+		}
 
-				opts := s3.GetObjectOptions{}
-				endOffset, err = strconv.Atoi(readEndOffset)
-				if err != nil {
-					return nil, util.NewUserError(codes.InvalidArgument, "Invalid range.")
-				}
-				err = opts.SetRange(0, int64(endOffset))
-				if err != nil {
-					log.WithFields(log.Fields{
-						"Namespace":     namespace,
-						"UID":           uid,
-						"PodName":       podName,
-						"ContainerName": containerName,
-						"Error":         err.Error(),
-					}).Error("Can't set range.")
-					return nil, util.NewUserError(codes.NotFound, "Can't connect to S3 storage.")
-				}
+		endOffset, err = strconv.Atoi(readEndOffset)
+		if err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, "Invalid range.")
+		}
+		// A negative range reads the end of the log, like the S3 range bytes=-N
+		offset, length := int64(0), int64(endOffset)+1
+		if endOffset < 0 {
+			offset, length = int64(endOffset), 0
+		}
 
-				key := config.ArtifactRepository.S3.FormatKey(namespace, uid, podName) + "/" + containerName + ".log"
-				stream, err = s3Client.GetObject(config.ArtifactRepository.S3.Bucket, key, opts)
-			}
-		case config.ArtifactRepository.GCS != nil:
-			{
-				gcsClient, err = c.GetGCSClient(namespace, config.ArtifactRepository.GCS)
-				if err != nil {
-					log.WithFields(log.Fields{
-						"Namespace":     namespace,
-						"UID":           uid,
-						"PodName":       podName,
-						"ContainerName": containerName,
-						"Error":         err.Error(),
-					}).Error("Can't connect to GCS storage.")
-					return nil, util.NewUserError(codes.NotFound, "Can't connect to GCS storage.")
-				}
-				key := config.ArtifactRepository.GCS.FormatKey(namespace, uid, podName) + "/" + containerName + ".log"
-				stream, err = gcsClient.GetObject(config.ArtifactRepository.GCS.Bucket, key)
-			}
+		var artifact *ArtifactObject
+		key := config.ArtifactRepository.FormatKey(namespace, uid, podName) + "/" + containerName + ".log"
+		artifact, err = getArtifactStream(storage, namespace, key, offset, length)
+		if err == nil {
+			stream = artifact.Reader
 		}
 	} else {
 		stream, err = c.CoreV1().Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{
@@ -972,20 +1036,14 @@ func (c *Client) GetWorkflowExecutionLogs(namespace, uid, podName, containerName
 	return logWatcher, err
 }
 
-func (c *Client) GetWorkflowExecutionMetrics(namespace, uid, podName string) (metrics []*Metric, err error) {
+// getWorkflowExecutionMetricsArtifact reads the metrics from the sys-metrics.json artifact written when the pod finishes
+func (c *Client) getWorkflowExecutionMetricsArtifact(namespace, uid, podName string) (metrics []*Metric, err error) {
 	_, err = c.GetWorkflowExecution(namespace, uid)
 	if err != nil {
 		return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
 	}
 
-	var (
-		stream    io.ReadCloser
-		s3Client  *s3.Client
-		gcsClient *gcs.Client
-		config    *NamespaceConfig
-	)
-
-	config, err = c.GetNamespaceConfig(namespace)
+	config, err := c.GetNamespaceConfig(namespace)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"Namespace": namespace,
@@ -996,59 +1054,29 @@ func (c *Client) GetWorkflowExecutionMetrics(namespace, uid, podName string) (me
 		return nil, util.NewUserError(codes.NotFound, "Can't get configuration.")
 	}
 
-	switch {
-	case config.ArtifactRepository.S3 != nil:
-		{
-			s3Client, err = c.GetS3Client(namespace, config.ArtifactRepository.S3)
-			if err != nil {
-				log.WithFields(log.Fields{
-					"Namespace": namespace,
-					"UID":       uid,
-					"PodName":   podName,
-					"Error":     err.Error(),
-				}).Error("Can't connect to S3 storage.")
-				return nil, util.NewUserError(codes.NotFound, "Can't connect to S3 storage.")
-			}
-
-			opts := s3.GetObjectOptions{}
+	storage, err := c.getStorage(namespace, &config.ArtifactRepository)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"PodName":   podName,
+			"Error":     err.Error(),
+		}).Error("Can't connect to artifact storage.")
+		return nil, util.NewUserError(codes.NotFound, "Can't connect to artifact storage.")
+	}
 
-			key := config.ArtifactRepository.S3.FormatKey(namespace, uid, podName) + "/sys-metrics.json"
-			stream, err = s3Client.GetObject(config.ArtifactRepository.S3.Bucket, key, opts)
-			if err != nil {
-				log.WithFields(log.Fields{
-					"Namespace": namespace,
-					"UID":       uid,
-					"PodName":   podName,
-					"Error":     err.Error(),
-				}).Error("Metrics do not exist.")
-				return nil, util.NewUserError(codes.NotFound, "Metrics do not exist.")
-			}
-		}
-	case config.ArtifactRepository.GCS != nil:
-		{
-			gcsClient, err = c.GetGCSClient(namespace, config.ArtifactRepository.GCS)
-			if err != nil {
-				log.WithFields(log.Fields{
-					"Namespace": namespace,
-					"UID":       uid,
-					"PodName":   podName,
-					"Error":     err.Error(),
-				}).Error("Can't connect to GCS storage.")
-				return nil, util.NewUserError(codes.NotFound, "Can't connect to GCS storage.")
-			}
-			key := config.ArtifactRepository.GCS.FormatKey(namespace, uid, podName) + "/sys-metrics.json"
-			stream, err = gcsClient.GetObject(config.ArtifactRepository.GCS.Bucket, key)
-			if err != nil {
-				log.WithFields(log.Fields{
-					"Namespace": namespace,
-					"UID":       uid,
-					"PodName":   podName,
-					"Error":     err.Error(),
-				}).Error("Metrics do not exist.")
-				return nil, util.NewUserError(codes.NotFound, "Metrics do not exist.")
-			}
-		}
+	key := config.ArtifactRepository.FormatKey(namespace, uid, podName) + "/sys-metrics.json"
+	stream, err := storage.Get(key, 0, 0)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"PodName":   podName,
+			"Error":     err.Error(),
+		}).Error("Metrics do not exist.")
+		return nil, util.NewUserError(codes.NotFound, "Metrics do not exist.")
 	}
+	defer stream.Close()
 
 	content, err := ioutil.ReadAll(stream)
 	if err != nil {
@@ -1084,6 +1112,23 @@ func (c *Client) RetryWorkflowExecution(namespace, uid string) (workflow *Workfl
 	}
 
 	wf, err = argoutil.RetryWorkflow(c, c.ArgoprojV1alpha1().Workflows(namespace), wf)
+	if err != nil {
+		return
+	}
+
+	// The execution runs again, so it is no longer finished. Otherwise the reconciler keeps the previous phase and finished_at.
+	_, err = sb.Update("workflow_executions").
+		Set("phase", wfv1.NodeRunning).
+		Set("finished_at", nil).
+		Where(sq.Eq{
+			"uid":       uid,
+			"namespace": namespace,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return
+	}
 
 	workflow = typeWorkflow(wf)
 
@@ -1151,148 +1196,180 @@ func (c *Client) TerminateWorkflowExecution(namespace, uid string) (err error) {
 	return
 }
 
-func (c *Client) GetArtifact(namespace, uid, key string) (data []byte, err error) {
-	config, err := c.GetNamespaceConfig(namespace)
-	if err != nil {
-		return
+// ListWorkflowExecutionUIDs returns the uids of all the workflow executions matching the filter.
+// workflowTemplateUID is ignored if it is empty.
+func (c *Client) ListWorkflowExecutionUIDs(namespace, workflowTemplateUID string, filter *WorkflowExecutionFilter) (uids []string, err error) {
+	sb := workflowExecutionsSelectBuilderNoColumns(namespace, workflowTemplateUID, "", filter).
+		Columns("we.uid").
+		OrderBy("we.created_at DESC", "we.id DESC")
+
+	uids = make([]string, 0)
+	err = c.DB.Selectx(&uids, sb)
+
+	return
+}
+
+// getBatchConcurrency returns how many workflow executions are processed at a time by operations on many executions
+func getBatchConcurrency() int {
+	concurrency, err := strconv.Atoi(batchConcurrency)
+	if err != nil || concurrency < 1 {
+		return 1
 	}
-	var (
-		stream io.ReadCloser
-	)
-	switch {
-	case config.ArtifactRepository.S3 != nil:
-		{
-			s3Client, err := c.GetS3Client(namespace, config.ArtifactRepository.S3)
-			if err != nil {
-				return nil, err
-			}
 
-			opts := s3.GetObjectOptions{}
-			stream, err = s3Client.GetObject(config.ArtifactRepository.S3.Bucket, key, opts)
-			if err != nil {
-				log.WithFields(log.Fields{
-					"Namespace": namespace,
-					"UID":       uid,
-					"Key":       key,
-					"Error":     err.Error(),
-				}).Error("Artifact does not exist.")
-				return nil, err
-			}
+	return concurrency
+}
+
+// BatchWorkflowExecutions applies the action to each of the workflow executions identified by uids.
+// Executions are processed concurrently, up to WORKFLOW_EXECUTION_BATCH_CONCURRENCY at a time.
+// A failure for one execution does not stop the others; every uid gets a result, in the order given.
+func (c *Client) BatchWorkflowExecutions(namespace string, action WorkflowExecutionBatchAction, uids []string) (results []*WorkflowExecutionBatchResult, err error) {
+	var apply func(namespace, uid string) error
+	switch action {
+	case WorkflowExecutionBatchTerminate:
+		apply = c.TerminateWorkflowExecution
+	case WorkflowExecutionBatchArchive:
+		apply = c.ArchiveWorkflowExecution
+	case WorkflowExecutionBatchRetry:
+		apply = func(namespace, uid string) error {
+			_, err := c.RetryWorkflowExecution(namespace, uid)
+			return err
 		}
-	case config.ArtifactRepository.GCS != nil:
-		{
-			gcsClient, err := c.GetGCSClient(namespace, config.ArtifactRepository.GCS)
+	default:
+		return nil, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Unknown batch action '%v'.", action))
+	}
 
-			if err != nil {
-				log.WithFields(log.Fields{
-					"Namespace": namespace,
-					"UID":       uid,
-					"Error":     err.Error(),
-				}).Error("Artifact does not exist.")
-				return nil, util.NewUserError(codes.NotFound, "Artifact does not exist.")
+	concurrency := getBatchConcurrency()
+
+	// Remove duplicates so the same execution isn't acted on concurrently
+	seen := make(map[string]bool)
+	uniqueUIDs := make([]string, 0, len(uids))
+	for _, uid := range uids {
+		if uid == "" || seen[uid] {
+			continue
+		}
+		seen[uid] = true
+		uniqueUIDs = append(uniqueUIDs, uid)
+	}
+
+	results = make([]*WorkflowExecutionBatchResult, len(uniqueUIDs))
+	semaphore := make(chan struct{}, concurrency)
+	wg := sync.WaitGroup{}
+	for i, uid := range uniqueUIDs {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, uid string) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			result := &WorkflowExecutionBatchResult{
+				UID:   uid,
+				Error: apply(namespace, uid),
 			}
-			stream, err = gcsClient.GetObject(config.ArtifactRepository.GCS.Bucket, key)
-			if err != nil {
+			if result.Error != nil {
 				log.WithFields(log.Fields{
 					"Namespace": namespace,
 					"UID":       uid,
-					"Error":     err.Error(),
-				}).Error("Artifact does not exist.")
-				return nil, util.NewUserError(codes.NotFound, "Artifact does not exist.")
+					"Action":    action,
+					"Error":     result.Error.Error(),
+				}).Error("Batch action failed.")
 			}
-		}
+			results[i] = result
+		}(i, uid)
 	}
+	wg.Wait()
+
+	return
+}
 
-	data, err = ioutil.ReadAll(stream)
+// GetArtifact reads the whole artifact into memory. Use GetArtifactStream for large artifacts.
+// An empty repository reads from the default artifact repository.
+func (c *Client) GetArtifact(namespace, uid, repository, key string) (data []byte, err error) {
+	artifact, err := c.GetArtifactStream(namespace, repository, key, 0, 0)
 	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Key":       key,
+			"Error":     err.Error(),
+		}).Error("Unable to get artifact.")
 		return
 	}
+	defer artifact.Reader.Close()
 
-	return
+	return ioutil.ReadAll(artifact.Reader)
 }
 
-func (c *Client) ListFiles(namespace, key string) (files []*File, err error) {
+// ListFiles returns the files and directories directly under key in the namespace's artifact repository with the name,
+// or the default repository if repository is empty. Files include a presigned download URL if the repository supports them.
+// key must be under the namespace's prefix, an empty key lists the namespace's prefix.
+func (c *Client) ListFiles(namespace, repository, key string) (files []*File, err error) {
 	config, err := c.GetNamespaceConfig(namespace)
 	if err != nil {
 		return
 	}
 
+	provider, err := config.GetArtifactRepository(repository)
+	if err != nil {
+		return
+	}
+	if key == "" {
+		key = provider.NamespacePrefix(namespace)
+	}
+	if err = validateNamespaceArtifactKey(provider, namespace, strings.TrimSuffix(key, "/")+"/"); err != nil {
+		return
+	}
+
+	storage, err := c.getStorage(namespace, provider)
+	if err != nil {
+		return
+	}
+
 	files = make([]*File, 0)
+	expiresAt := time.Now().Add(config.PresignedURLExpiration).UTC()
 
 	if len(key) > 0 {
 		if string(key[len(key)-1]) != "/" {
 			key += "/"
 		}
 	}
-	switch {
-	case config.ArtifactRepository.S3 != nil:
-		{
-			s3Client, err := c.GetS3Client(namespace, config.ArtifactRepository.S3)
-			if err != nil {
-				return nil, err
-			}
-
-			doneCh := make(chan struct{})
-			defer close(doneCh)
-			for objInfo := range s3Client.ListObjectsV2(config.ArtifactRepository.S3.Bucket, key, false, doneCh) {
-				if objInfo.Key == key {
-					continue
-				}
 
-				isDirectory := (objInfo.ETag == "" || strings.HasSuffix(objInfo.Key, "/")) && objInfo.Size == 0
+	objects, err := storage.List(key, false)
+	if err != nil {
+		return nil, err
+	}
 
-				newFile := &File{
-					Path:         objInfo.Key,
-					Name:         FilePathToName(objInfo.Key),
-					Extension:    FilePathToExtension(objInfo.Key),
-					Size:         objInfo.Size,
-					LastModified: objInfo.LastModified,
-					ContentType:  objInfo.ContentType,
-					Directory:    isDirectory,
-				}
-				files = append(files, newFile)
-			}
+	for _, object := range objects {
+		if object.Key == key {
+			continue
 		}
-	case config.ArtifactRepository.GCS != nil:
-		{
-			ctx := context.Background()
-			gcsClient, err := c.GetGCSClient(namespace, config.ArtifactRepository.GCS)
-			if err != nil {
-				return nil, err
-			}
-			q := &storage.Query{
-				Delimiter: "",
-				Prefix:    key,
-				Versions:  false,
-			}
-			bucketFiles := gcsClient.Bucket(config.ArtifactRepository.GCS.Bucket).Objects(ctx, q)
 
-			for true {
-				file, err := bucketFiles.Next()
-				if err != nil {
-					if err.Error() == "no more items in iterator" {
-						break
-					}
-					return nil, err
-				}
-				if file.Name == key {
-					continue
-				}
-				isDirectory := (file.Etag == "" || strings.HasSuffix(file.Name, "/")) && file.Size == 0
-
-				newFile := &File{
-					Path:         file.Name,
-					Name:         FilePathToName(file.Name),
-					Extension:    FilePathToExtension(file.Name),
-					Size:         file.Size,
-					LastModified: file.Updated,
-					ContentType:  file.ContentType,
-					Directory:    isDirectory,
-				}
-				files = append(files, newFile)
+		newFile := &File{
+			Path:         object.Key,
+			Name:         FilePathToName(object.Key),
+			Extension:    FilePathToExtension(object.Key),
+			Size:         object.Size,
+			LastModified: object.LastModified,
+			ContentType:  object.ContentType,
+			Directory:    object.Directory,
+		}
+		if !newFile.Directory {
+			url, err := storage.Presign(object.Key, http.MethodGet, "", expiresAt)
+			if err == nil {
+				newFile.URL = url
+				newFile.URLExpiresAt = &expiresAt
+			} else if err != ErrStoragePresignNotSupported {
+				log.WithFields(log.Fields{
+					"Namespace": namespace,
+					"Key":       object.Key,
+					"Error":     err.Error(),
+				}).Error("Unable to presign file URL.")
 			}
 		}
+		files = append(files, newFile)
 	}
+
 	return
 }
 
@@ -1697,11 +1774,58 @@ func injectWorkflowExecutionStatusCaller(wf *wfv1.Workflow, phase wfv1.NodePhase
 	return nil
 }
 
-func workflowExecutionsSelectBuilderNoColumns(namespace, workflowTemplateUID, workflowTemplateVersion string) sq.SelectBuilder {
+// applyWorkflowExecutionFilter adds the where clauses for the filter to the select builder.
+// The builder is expected to alias workflow_executions as "we".
+func applyWorkflowExecutionFilter(sb sq.SelectBuilder, filter *WorkflowExecutionFilter) sq.SelectBuilder {
+	if filter == nil || filter.Archived == nil {
+		sb = sb.Where(sq.Eq{"we.is_archived": false})
+	} else {
+		sb = sb.Where(sq.Eq{"we.is_archived": *filter.Archived})
+	}
+
+	if filter == nil {
+		return sb
+	}
+
+	if len(filter.Phases) > 0 {
+		sb = sb.Where(sq.Eq{"we.phase": filter.Phases})
+	}
+	if filter.StartedAfter != nil {
+		sb = sb.Where(sq.GtOrEq{"we.started_at": filter.StartedAfter.UTC()})
+	}
+	if filter.StartedBefore != nil {
+		sb = sb.Where(sq.Lt{"we.started_at": filter.StartedBefore.UTC()})
+	}
+	if filter.FinishedAfter != nil {
+		sb = sb.Where(sq.GtOrEq{"we.finished_at": filter.FinishedAfter.UTC()})
+	}
+	if filter.FinishedBefore != nil {
+		sb = sb.Where(sq.Lt{"we.finished_at": filter.FinishedBefore.UTC()})
+	}
+	if filter.Name != "" {
+		escaper := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+		sb = sb.Where("we.name ILIKE ?", "%"+escaper.Replace(filter.Name)+"%")
+	}
+	if len(filter.Labels) > 0 {
+		labelsJSON, err := LabelsToJSONString(filter.Labels)
+		if err != nil {
+			log.Printf("[error] %v", err)
+		} else {
+			sb = sb.Where("we.labels @> ?", labelsJSON)
+		}
+	}
+
+	return sb
+}
+
+// workflowExecutionsSelectBuilderNoColumns selects the workflow executions in the namespace that match the filter.
+// workflowTemplateUID and workflowTemplateVersion are ignored if they are empty.
+func workflowExecutionsSelectBuilderNoColumns(namespace, workflowTemplateUID, workflowTemplateVersion string, filter *WorkflowExecutionFilter) sq.SelectBuilder {
 	whereMap := sq.Eq{
-		"wt.namespace":   namespace,
-		"wt.uid":         workflowTemplateUID,
-		"we.is_archived": false,
+		"wt.namespace": namespace,
+	}
+	if workflowTemplateUID != "" {
+		whereMap["wt.uid"] = workflowTemplateUID
 	}
 	if workflowTemplateVersion != "" {
 		whereMap["wtv.version"] = workflowTemplateVersion
@@ -1713,11 +1837,11 @@ func workflowExecutionsSelectBuilderNoColumns(namespace, workflowTemplateUID, wo
 		LeftJoin("workflow_templates wt ON wt.id = wtv.workflow_template_id").
 		Where(whereMap)
 
-	return sb
+	return applyWorkflowExecutionFilter(sb, filter)
 }
 
-func workflowExecutionsSelectBuilder(namespace, workflowTemplateUID, workflowTemplateVersion string) sq.SelectBuilder {
-	sb := workflowExecutionsSelectBuilderNoColumns(namespace, workflowTemplateUID, workflowTemplateVersion)
+func workflowExecutionsSelectBuilder(namespace, workflowTemplateUID, workflowTemplateVersion string, filter *WorkflowExecutionFilter) sq.SelectBuilder {
+	sb := workflowExecutionsSelectBuilderNoColumns(namespace, workflowTemplateUID, workflowTemplateVersion, filter)
 	sb = sb.Columns(getWorkflowExecutionColumns("we", "")...).
 		Columns(`wtv.version "workflow_template.version"`, `wtv.created_at "workflow_template.created_at"`)
 
@@ -1775,3 +1899,242 @@ func (c *Client) UpdateWorkflowExecutionStatus(namespace, uid string, status *Wo
 
 	return
 }
+
+// workflowExecutionStatusFromArgo returns the status Argo reports for the workflow
+func workflowExecutionStatusFromArgo(wf *wfv1.Workflow) *WorkflowExecutionStatus {
+	status := &WorkflowExecutionStatus{
+		Phase: wf.Status.Phase,
+	}
+
+	if !wf.Status.StartedAt.IsZero() {
+		startedAt := wf.Status.StartedAt.UTC()
+		status.StartedAt = &startedAt
+	}
+
+	if !wf.Status.FinishedAt.IsZero() {
+		finishedAt := wf.Status.FinishedAt.UTC()
+		status.FinishedAt = &finishedAt
+	}
+
+	return status
+}
+
+// repairWorkflowExecutionStatus returns the status the database should have given the one reported by Argo,
+// or nil if nothing needs to change.
+//
+// Timestamps that are already set are kept, as the exit handler records them slightly before Argo does.
+// Executions terminated through the API keep their Terminated phase, and finished executions
+// are not moved back to a running phase while Argo is still running the exit handler.
+// RetryWorkflowExecution clears finished_at, so retried executions are repaired again.
+func repairWorkflowExecutionStatus(current, argo *WorkflowExecutionStatus) *WorkflowExecutionStatus {
+	if argo.Phase == "" || current.Phase == "Terminated" {
+		return nil
+	}
+
+	if current.FinishedAt != nil && !argo.Phase.Completed() {
+		return nil
+	}
+
+	result := *current
+	changed := false
+
+	if current.Phase != argo.Phase {
+		result.Phase = argo.Phase
+		changed = true
+	}
+
+	if current.StartedAt == nil && argo.StartedAt != nil {
+		result.StartedAt = argo.StartedAt
+		changed = true
+	}
+
+	if current.FinishedAt == nil && argo.FinishedAt != nil {
+		result.FinishedAt = argo.FinishedAt
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return &result
+}
+
+// updateWorkflowExecutionStatusByName sets the phase, started_at and finished_at of the non-archived workflow execution
+func (c *Client) updateWorkflowExecutionStatusByName(namespace, name string, status *WorkflowExecutionStatus) error {
+	_, err := sb.Update("workflow_executions").
+		SetMap(sq.Eq{
+			"phase":       status.Phase,
+			"started_at":  status.StartedAt,
+			"finished_at": status.FinishedAt,
+		}).
+		Where(sq.Eq{
+			"namespace":   namespace,
+			"name":        name,
+			"is_archived": false,
+		}).
+		RunWith(c.DB).
+		Exec()
+
+	return err
+}
+
+// getWorkflowExecutionStatusByName returns the status stored in the database for the non-archived workflow execution
+func (c *Client) getWorkflowExecutionStatusByName(namespace, name string) (status *WorkflowExecutionStatus, err error) {
+	query := sb.Select("we.phase", "we.started_at", "we.finished_at").
+		From("workflow_executions we").
+		Where(sq.Eq{
+			"we.namespace":   namespace,
+			"we.name":        name,
+			"we.is_archived": false,
+		})
+
+	status = &WorkflowExecutionStatus{}
+	if err = c.DB.Getx(status, query); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// ReconcileWorkflowExecution updates the database record of the workflow execution to match the Argo workflow.
+// If the record does not exist, or it is already up to date, nil is returned.
+func (c *Client) ReconcileWorkflowExecution(wf *wfv1.Workflow) (repair *WorkflowExecutionRepair, err error) {
+	current, err := c.getWorkflowExecutionStatusByName(wf.Namespace, wf.Name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	status := repairWorkflowExecutionStatus(current, workflowExecutionStatusFromArgo(wf))
+	if status == nil {
+		return nil, nil
+	}
+
+	if err := c.updateWorkflowExecutionStatusByName(wf.Namespace, wf.Name, status); err != nil {
+		return nil, err
+	}
+
+	return &WorkflowExecutionRepair{
+		Namespace: wf.Namespace,
+		UID:       wf.Name,
+		Previous:  *current,
+		Current:   *status,
+	}, nil
+}
+
+// ReconcileWorkflowExecutions checks every unfinished, non-archived workflow execution against its Argo workflow
+// and repairs the phase, started_at and finished_at columns.
+//
+// workflows is a store of the Argo workflows, such as the one of an informer, keyed by namespace/name.
+// Executions whose Argo workflow no longer exists are orphans: their phase is set to Error and finished_at to now.
+// Errors for individual executions are logged and counted in the report, and do not stop the others.
+func (c *Client) ReconcileWorkflowExecutions(workflows cache.Store) (report *WorkflowExecutionReconcileReport, err error) {
+	query := sb.Select("we.namespace", "we.name", "we.phase", "we.started_at", "we.finished_at").
+		From("workflow_executions we").
+		Where(sq.Eq{
+			"we.is_archived": false,
+			"we.finished_at": nil,
+		}).
+		OrderBy("we.id")
+
+	workflowExecutions := make([]*WorkflowExecution, 0)
+	if err = c.DB.Selectx(&workflowExecutions, query); err != nil {
+		return nil, err
+	}
+
+	report = &WorkflowExecutionReconcileReport{
+		Repairs: make([]*WorkflowExecutionRepair, 0),
+	}
+	for _, we := range workflowExecutions {
+		report.Checked++
+
+		repair, err := c.reconcileWorkflowExecutionRecord(workflows, we)
+		if err != nil {
+			report.Errors++
+			log.WithFields(log.Fields{
+				"Namespace": we.Namespace,
+				"UID":       we.Name,
+				"Error":     err.Error(),
+			}).Error("Unable to reconcile workflow execution.")
+			continue
+		}
+
+		if repair != nil {
+			report.Repairs = append(report.Repairs, repair)
+		}
+	}
+
+	return
+}
+
+// reconcileWorkflowExecutionRecord repairs a single database record, marking it as orphaned if the Argo workflow is gone.
+// The workflow is read from workflows, and only from Argo if it is not there, as the store may not have observed a new workflow yet.
+func (c *Client) reconcileWorkflowExecutionRecord(workflows cache.Store, we *WorkflowExecution) (repair *WorkflowExecutionRepair, err error) {
+	obj, exists, err := workflows.GetByKey(we.Namespace + "/" + we.Name)
+	if err != nil {
+		return nil, err
+	}
+	if wf, ok := obj.(*wfv1.Workflow); exists && ok {
+		return c.ReconcileWorkflowExecution(wf)
+	}
+
+	wf, err := c.ArgoprojV1alpha1().Workflows(we.Namespace).Get(we.Name, metav1.GetOptions{})
+	if err == nil {
+		return c.ReconcileWorkflowExecution(wf)
+	}
+
+	if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	return c.orphanWorkflowExecution(we.Namespace, we.Name, WorkflowExecutionStatus{
+		Phase:      we.Phase,
+		StartedAt:  we.StartedAt,
+		FinishedAt: we.FinishedAt,
+	})
+}
+
+// ReconcileDeletedWorkflowExecution updates the database record of a workflow execution whose Argo workflow was deleted.
+// A workflow that finished gets its final status, an unfinished one is marked as orphaned.
+// If the record does not exist, or it is already finished, nil is returned.
+func (c *Client) ReconcileDeletedWorkflowExecution(wf *wfv1.Workflow) (repair *WorkflowExecutionRepair, err error) {
+	current, err := c.getWorkflowExecutionStatusByName(wf.Namespace, wf.Name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if current.FinishedAt != nil {
+		return nil, nil
+	}
+
+	if wf.Status.Phase.Completed() {
+		return c.ReconcileWorkflowExecution(wf)
+	}
+
+	return c.orphanWorkflowExecution(wf.Namespace, wf.Name, *current)
+}
+
+// orphanWorkflowExecution sets the phase of the workflow execution to Error and finished_at to now
+func (c *Client) orphanWorkflowExecution(namespace, name string, current WorkflowExecutionStatus) (repair *WorkflowExecutionRepair, err error) {
+	finishedAt := time.Now().UTC()
+	status := current
+	status.Phase = wfv1.NodeError
+	status.FinishedAt = &finishedAt
+
+	if err := c.updateWorkflowExecutionStatusByName(namespace, name, &status); err != nil {
+		return nil, err
+	}
+
+	return &WorkflowExecutionRepair{
+		Namespace: namespace,
+		UID:       name,
+		Previous:  current,
+		Current:   status,
+		Orphaned:  true,
+	}, nil
+}
@@ -0,0 +1,304 @@
+package v1
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"io"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// workflowExecutionLog is the open log of a container of a workflow execution's pod
+type workflowExecutionLog struct {
+	io.ReadCloser
+	// node is the workflow node of the pod
+	node wfv1.NodeStatus
+	// timestamps is false if the log is archived without a timestamp at the start of every line
+	timestamps bool
+}
+
+// openWorkflowExecutionLog opens the log of the container.
+// The log of a completed pod is read from its .log artifact, otherwise it is read from the pod with a timestamp at the start of every line.
+// Argo archives logs without timestamps, unless the first line of the archived log has one, it is treated as having none.
+// If follow is set, the log of a running pod is followed and only the end of an archived log is read, like GetWorkflowExecutionLogs.
+func (c *Client) openWorkflowExecutionLog(namespace, uid, podName, containerName string, follow bool) (*workflowExecutionLog, error) {
+	logFields := log.Fields{
+		"Namespace":     namespace,
+		"UID":           uid,
+		"PodName":       podName,
+		"ContainerName": containerName,
+	}
+
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	if err != nil {
+		logFields["Error"] = err.Error()
+		log.WithFields(logFields).Error("Workflow not found.")
+		return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
+	}
+
+	node := wf.Status.Nodes[podName]
+	if !node.Completed() {
+		stream, err := c.CoreV1().Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{
+			Container:  containerName,
+			Follow:     follow,
+			Timestamps: true,
+		}).Stream()
+		if err != nil {
+			logFields["Error"] = err.Error()
+			log.WithFields(logFields).Error("Error with logs.")
+			return nil, util.NewUserError(codes.NotFound, "Log not found.")
+		}
+		return &workflowExecutionLog{ReadCloser: stream, node: node, timestamps: true}, nil
+	}
+
+	config, err := c.GetNamespaceConfig(namespace)
+	if err != nil {
+		return nil, err
+	}
+	storage, err := c.getStorage(namespace, &config.ArtifactRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	// Like GetWorkflowExecutionLogs, a negative ARTIFACT_RERPOSITORY_OBJECT_RANGE only reads the end of the archived log
+	offset := int64(0)
+	if end, err := strconv.ParseInt(readEndOffset, 10, 64); follow && err == nil && end < 0 {
+		offset = end
+	}
+
+	key := config.ArtifactRepository.FormatKey(namespace, uid, podName) + "/" + containerName + ".log"
+	artifact, err := getArtifactStream(storage, namespace, key, offset, 0)
+	if err != nil {
+		logFields["Error"] = err.Error()
+		log.WithFields(logFields).Error("Error with logs.")
+		return nil, util.NewUserError(codes.NotFound, "Log not found.")
+	}
+
+	// The end of the log most likely starts in the middle of a line, which is skipped
+	reader := bufio.NewReader(artifact.Reader)
+	if artifact.Offset > 0 {
+		if _, err := reader.ReadString('\n'); err != nil && err != io.EOF {
+			artifact.Reader.Close()
+			return nil, err
+		}
+	}
+	firstLine, err := reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		artifact.Reader.Close()
+		return nil, err
+	}
+	_, _, timestamps := parseLogLine(firstLine)
+
+	return &workflowExecutionLog{
+		ReadCloser: &logReader{Reader: io.MultiReader(strings.NewReader(firstLine), reader), Closer: artifact.Reader},
+		node:       node,
+		timestamps: timestamps,
+	}, nil
+}
+
+// logFilter returns the filter to apply to the log. The lines of a log without timestamps were logged while its node ran,
+// so a time range that contains the whole run selects all of them, and one that does not overlap it selects none, then skip is true.
+// Other time ranges can not be applied, they are an InvalidArgument error.
+func (l *workflowExecutionLog) logFilter(filter *LogFilter) (result *LogFilter, skip bool, err error) {
+	if l.timestamps || filter == nil || (filter.Since == nil && filter.Until == nil) {
+		return filter, false, nil
+	}
+
+	startedAt := l.node.StartedAt.UTC()
+	finishedAt := l.node.FinishedAt.UTC()
+	if (filter.Until != nil && !startedAt.Before(*filter.Until)) || (filter.Since != nil && finishedAt.Before(*filter.Since)) {
+		return filter, true, nil
+	}
+	if (filter.Since == nil || !startedAt.Before(*filter.Since)) && (filter.Until == nil || finishedAt.Before(*filter.Until)) {
+		result := *filter
+		result.Since = nil
+		result.Until = nil
+		return &result, false, nil
+	}
+
+	message := fmt.Sprintf("The archived log has no timestamps, it can only be filtered by a time range that contains the whole run of the pod, from %v to %v.",
+		startedAt.Format(time.RFC3339), finishedAt.Format(time.RFC3339))
+	return nil, false, util.NewUserError(codes.InvalidArgument, message)
+}
+
+// logReader reads a log through a buffered Reader and closes the underlying stream
+type logReader struct {
+	io.Reader
+	io.Closer
+}
+
+// WriteWorkflowExecutionLogs writes the lines of the container's log selected by filter to w, or the whole log if filter is empty.
+// Unlike GetWorkflowExecutionLogs, the log of a completed pod is not cut off and the log of a running pod is not followed.
+func (c *Client) WriteWorkflowExecutionLogs(namespace, uid, podName, containerName string, filter *LogFilter, w io.Writer) error {
+	stream, err := c.openWorkflowExecutionLog(namespace, uid, podName, containerName, false)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	filter, skip, err := stream.logFilter(filter)
+	if err != nil || skip {
+		return err
+	}
+	if filter.IsEmpty() {
+		_, err = io.Copy(w, stream)
+		return err
+	}
+
+	return filterLogLines(stream, filter, func(line string, entry *LogEntry) error {
+		_, err := io.WriteString(w, line)
+		return err
+	})
+}
+
+// SearchWorkflowExecutionLogs calls fn with every line of the container's log selected by filter.
+// The log is read on the server, so only the matching lines are sent to the caller.
+func (c *Client) SearchWorkflowExecutionLogs(namespace, uid, podName, containerName string, filter *LogFilter, fn func(entry *LogEntry) error) error {
+	stream, err := c.openWorkflowExecutionLog(namespace, uid, podName, containerName, false)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	filter, skip, err := stream.logFilter(filter)
+	if err != nil || skip {
+		return err
+	}
+
+	// Lines without timestamps are sorted at the start of the pod, like in AggregateWorkflowExecutionLogs
+	return filterLogLines(stream, filter, func(line string, entry *LogEntry) error {
+		if entry.Timestamp.IsZero() {
+			entry.Timestamp = stream.node.StartedAt.UTC()
+		}
+		return fn(entry)
+	})
+}
+
+// logAggregationDelay is how long entries of running pods are held back, so entries other pods logged at the same time are sorted before them
+var logAggregationDelay = 2 * time.Second
+
+// AggregateWorkflowExecutionLogs calls fn with the entries of the container's log in every pod of the workflow execution, in timestamp order.
+// If nodeNames is set, only the pods of those nodes are included. Pods are added as the workflow starts them.
+// It returns once the workflow has finished and every log has been read, when fn returns an error, or when done is closed.
+func (c *Client) AggregateWorkflowExecutionLogs(namespace, uid string, nodeNames []string, containerName string, done <-chan struct{}, fn func(entry *LogEntry) error) error {
+	watcher, err := c.WatchWorkflowExecution(namespace, uid)
+	if err != nil {
+		return err
+	}
+
+	stop := make(chan struct{})
+	defer func() {
+		close(stop)
+		// WatchWorkflowExecution blocks until its updates are read
+		go func() {
+			for range watcher {
+			}
+		}()
+	}()
+
+	selectedNodes := make(map[string]bool)
+	for _, name := range nodeNames {
+		selectedNodes[name] = true
+	}
+
+	aggregator := newLogAggregator(logAggregationDelay)
+	ticker := time.NewTicker(logAggregationDelay / 4)
+	defer ticker.Stop()
+
+	updates := watcher
+	for {
+		select {
+		case <-done:
+			return nil
+		case execution, ok := <-updates:
+			if !ok {
+				updates = nil
+				continue
+			}
+
+			workflow := &wfv1.Workflow{}
+			if err := json.Unmarshal([]byte(execution.Manifest), workflow); err != nil {
+				return err
+			}
+			for _, node := range workflow.Status.Nodes {
+				if node.Type != wfv1.NodeTypePod || node.StartedAt.IsZero() {
+					continue
+				}
+				if node.Phase != wfv1.NodeRunning && node.Phase != wfv1.NodeSucceeded && node.Phase != wfv1.NodeFailed && node.Phase != wfv1.NodeError {
+					continue
+				}
+				if len(selectedNodes) > 0 && !selectedNodes[node.DisplayName] && !selectedNodes[node.Name] && !selectedNodes[node.ID] {
+					continue
+				}
+				if !aggregator.addSource(node.ID, !node.Completed()) {
+					continue
+				}
+
+				go c.readAggregatedLog(namespace, uid, node, containerName, aggregator, stop)
+			}
+		case <-ticker.C:
+			all := updates == nil && aggregator.finished()
+			for _, entry := range aggregator.flush(time.Now(), all) {
+				if err := fn(entry); err != nil {
+					return err
+				}
+			}
+			if all {
+				return nil
+			}
+		}
+	}
+}
+
+// setNodeLogEntryFields tags the entry with the node and container it was logged by.
+// Archived logs may not have timestamps, so their lines are sorted at the start of the pod.
+func setNodeLogEntryFields(entry *LogEntry, node wfv1.NodeStatus, containerName string) {
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = node.StartedAt.UTC()
+	}
+	entry.NodeName = node.DisplayName
+	entry.PodName = node.ID
+	entry.ContainerName = containerName
+}
+
+// readAggregatedLog adds the entries of the node's log to aggregator until the log ends or stop is closed
+func (c *Client) readAggregatedLog(namespace, uid string, node wfv1.NodeStatus, containerName string, aggregator *logAggregator, stop <-chan struct{}) {
+	defer aggregator.finish(node.ID)
+
+	stream, err := c.openWorkflowExecutionLog(namespace, uid, node.ID, containerName, true)
+	if err != nil {
+		return
+	}
+	go func() {
+		<-stop
+		stream.Close()
+	}()
+
+	err = filterLogLines(stream, nil, func(line string, entry *LogEntry) error {
+		setNodeLogEntryFields(entry, node, containerName)
+		aggregator.add(node.ID, entry)
+
+		return nil
+	})
+	if err != nil {
+		select {
+		case <-stop:
+		default:
+			log.WithFields(log.Fields{
+				"Namespace":     namespace,
+				"UID":           uid,
+				"PodName":       node.ID,
+				"ContainerName": containerName,
+				"Error":         err.Error(),
+			}).Error("Unable to read log.")
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package v1
+
+import (
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	argoFake "github.com/argoproj/argo/pkg/client/clientset/versioned/fake"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"io/ioutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newTestArchivedLogClient creates a client with a completed pod whose log is archived by Argo, without timestamps,
+// in a filesystem artifact repository
+func newTestArchivedLogClient(t *testing.T, startedAt, finishedAt time.Time) *Client {
+	root, err := ioutil.TempDir("", "artifacts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileSystemRoot := artifactFileSystemRoot
+	artifactFileSystemRoot = root
+	t.Cleanup(func() {
+		artifactFileSystemRoot = fileSystemRoot
+		os.RemoveAll(root)
+	})
+
+	dir := filepath.Join(root, "onepanel", "artifacts", "onepanel", "wf", "wf-1")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "main.log"), []byte("first\nsecond\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	configMap := mockSystemConfigMap.DeepCopy()
+	configMap.Data["artifactRepository"] = `filesystem:
+  keyFormat: artifacts/{{workflow.namespace}}/{{workflow.name}}/{{pod.name}}`
+
+	argoClient := argoFake.NewSimpleClientset(&wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "wf",
+			Namespace: "onepanel",
+		},
+		Status: wfv1.WorkflowStatus{
+			Nodes: map[string]wfv1.NodeStatus{
+				"wf-1": {
+					ID:         "wf-1",
+					Type:       wfv1.NodeTypePod,
+					Phase:      wfv1.NodeSucceeded,
+					StartedAt:  metav1.NewTime(startedAt),
+					FinishedAt: metav1.NewTime(finishedAt),
+				},
+			},
+		},
+	})
+
+	return &Client{
+		Interface:        fake.NewSimpleClientset(configMap, mockSystemSecret.DeepCopy()),
+		argoprojV1alpha1: argoClient.ArgoprojV1alpha1(),
+	}
+}
+
+// TestClient_SearchWorkflowExecutionLogs_Archived makes sure an archived log without timestamps is selected by time ranges
+// that contain the whole run of its pod, skipped by ones that do not overlap it, and rejected otherwise
+func TestClient_SearchWorkflowExecutionLogs_Archived(t *testing.T) {
+	startedAt := time.Date(2020, 9, 1, 10, 0, 0, 0, time.UTC)
+	finishedAt := startedAt.Add(time.Hour)
+	c := newTestArchivedLogClient(t, startedAt, finishedAt)
+
+	search := func(since, until time.Time) (contents []string, err error) {
+		filter, err := NewLogFilter("", false, &since, &until, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = c.SearchWorkflowExecutionLogs("onepanel", "wf", "wf-1", "main", filter, func(entry *LogEntry) error {
+			assert.Equal(t, startedAt, entry.Timestamp)
+			contents = append(contents, entry.Content)
+			return nil
+		})
+		return
+	}
+
+	contents, err := search(startedAt, finishedAt.Add(time.Second))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"first", "second"}, contents)
+
+	contents, err = search(finishedAt.Add(time.Second), finishedAt.Add(time.Hour))
+	assert.Nil(t, err)
+	assert.Empty(t, contents)
+
+	contents, err = search(startedAt.Add(-time.Hour), startedAt)
+	assert.Nil(t, err)
+	assert.Empty(t, contents)
+
+	_, err = search(startedAt.Add(time.Minute), finishedAt.Add(time.Second))
+	if assert.NotNil(t, err) {
+		assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).Code)
+	}
+}
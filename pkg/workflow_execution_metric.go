@@ -0,0 +1,488 @@
+package v1
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/pagination"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// workflowExecutionMetricsPollInterval is how often WatchWorkflowExecutionMetrics checks for new metric points
+	workflowExecutionMetricsPollInterval = 2 * time.Second
+)
+
+// workflowExecutionMetricRecord is the workflow execution a metric point belongs to
+type workflowExecutionMetricRecord struct {
+	ID         uint64
+	FinishedAt *time.Time `db:"finished_at"`
+}
+
+// getWorkflowExecutionMetricRecord returns the non-archived workflow execution metric points are added to
+func (c *Client) getWorkflowExecutionMetricRecord(namespace, uid string) (record *workflowExecutionMetricRecord, err error) {
+	query := sb.Select("we.id", "we.finished_at").
+		From("workflow_executions we").
+		Where(sq.Eq{
+			"we.namespace":   namespace,
+			"we.uid":         uid,
+			"we.is_archived": false,
+		})
+
+	record = &workflowExecutionMetricRecord{}
+	if err = c.DB.Getx(record, query); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, util.NewUserError(codes.NotFound, "Workflow execution not found.")
+		}
+		return nil, err
+	}
+
+	return
+}
+
+// AddWorkflowExecutionMetrics appends the metric points to the pod of the workflow execution.
+// The pod must be a node of the execution's Argo workflow. Points without a Timestamp are recorded at the current time.
+func (c *Client) AddWorkflowExecutionMetrics(namespace, uid, podName string, metrics []*WorkflowExecutionMetric) error {
+	if podName == "" {
+		return util.NewUserError(codes.InvalidArgument, "Pod name is required.")
+	}
+	if len(metrics) == 0 {
+		return util.NewUserError(codes.InvalidArgument, "At least one metric is required.")
+	}
+
+	record, err := c.getWorkflowExecutionMetricRecord(namespace, uid)
+	if err != nil {
+		return err
+	}
+
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return util.NewUserError(codes.NotFound, "Workflow not found.")
+		}
+		return err
+	}
+	if node, ok := wf.Status.Nodes[podName]; !ok || node.Type != wfv1.NodeTypePod {
+		return util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Pod %v is not a node of the workflow execution.", podName))
+	}
+
+	now := time.Now().UTC()
+	query := sb.Insert("workflow_execution_metrics").
+		Columns("workflow_execution_id", "pod_name", "name", "value", "format", "step", "timestamp")
+	for _, metric := range metrics {
+		if metric.Name == "" {
+			return util.NewUserError(codes.InvalidArgument, "Metric name is required.")
+		}
+
+		timestamp := metric.Timestamp.UTC()
+		if metric.Timestamp.IsZero() {
+			timestamp = now
+		}
+
+		query = query.Values(record.ID, podName, metric.Name, metric.Value, metric.Format, metric.Step, timestamp)
+	}
+
+	if _, err := query.RunWith(c.DB).Exec(); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"PodName":   podName,
+			"Error":     err.Error(),
+		}).Error("Unable to add workflow execution metrics.")
+		return util.NewUserError(codes.Unknown, "Unable to add metrics.")
+	}
+
+	return nil
+}
+
+// listWorkflowExecutionMetricPoints returns the metric points of the pod added after the point with id afterID, oldest first
+func (c *Client) listWorkflowExecutionMetricPoints(workflowExecutionID uint64, podName string, afterID uint64) (metrics []*WorkflowExecutionMetric, err error) {
+	query := sb.Select("id", "pod_name", "name", "value", "format", "step", "timestamp").
+		From("workflow_execution_metrics").
+		Where(sq.Eq{
+			"workflow_execution_id": workflowExecutionID,
+			"pod_name":              podName,
+		}).
+		Where(sq.Gt{"id": afterID}).
+		OrderBy("id")
+
+	metrics = make([]*WorkflowExecutionMetric, 0)
+	err = c.DB.Selectx(&metrics, query)
+
+	return
+}
+
+// getLatestWorkflowExecutionMetrics returns the most recently added value of each metric pushed by the pod
+func (c *Client) getLatestWorkflowExecutionMetrics(namespace, uid, podName string) (metrics []*Metric, err error) {
+	record, err := c.getWorkflowExecutionMetricRecord(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	query := sb.Select("DISTINCT ON (name) name", "value", "format").
+		From("workflow_execution_metrics").
+		Where(sq.Eq{
+			"workflow_execution_id": record.ID,
+			"pod_name":              podName,
+		}).
+		OrderBy("name", "id DESC")
+
+	metrics = make([]*Metric, 0)
+	err = c.DB.Selectx(&metrics, query)
+
+	return
+}
+
+// WatchWorkflowExecutionMetrics streams the metric points of the pod, starting with the ones already added.
+// The channel is closed when ctx is done, or once the workflow execution has finished and all points were sent.
+// If the points can no longer be read, the channel is closed too. The error is then sent to errs, which receives nil otherwise.
+func (c *Client) WatchWorkflowExecutionMetrics(ctx context.Context, namespace, uid, podName string) (metricWatcher <-chan *WorkflowExecutionMetric, errs <-chan error, err error) {
+	record, err := c.getWorkflowExecutionMetricRecord(namespace, uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metrics := make(chan *WorkflowExecutionMetric)
+	watchErrs := make(chan error, 1)
+	go func() {
+		err := c.watchWorkflowExecutionMetrics(ctx, namespace, uid, podName, record, metrics)
+		watchErrs <- err
+		close(watchErrs)
+		close(metrics)
+	}()
+
+	return metrics, watchErrs, nil
+}
+
+// watchWorkflowExecutionMetrics sends the metric points of the pod to metricWatcher, see WatchWorkflowExecutionMetrics
+func (c *Client) watchWorkflowExecutionMetrics(ctx context.Context, namespace, uid, podName string, record *workflowExecutionMetricRecord, metricWatcher chan<- *WorkflowExecutionMetric) (err error) {
+	lastID := uint64(0)
+	ticker := time.NewTicker(workflowExecutionMetricsPollInterval)
+	defer ticker.Stop()
+	for {
+		// Check if the execution finished before reading, so points added right before finishing are still sent
+		finished := record.FinishedAt != nil
+		if !finished {
+			record, err = c.getWorkflowExecutionMetricRecord(namespace, uid)
+			if err != nil {
+				log.WithFields(log.Fields{
+					"Namespace": namespace,
+					"UID":       uid,
+					"PodName":   podName,
+					"Error":     err.Error(),
+				}).Error("Unable to get workflow execution while watching metrics.")
+				return err
+			}
+			finished = record.FinishedAt != nil
+		}
+
+		metrics, err := c.listWorkflowExecutionMetricPoints(record.ID, podName, lastID)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"UID":       uid,
+				"PodName":   podName,
+				"Error":     err.Error(),
+			}).Error("Unable to list workflow execution metrics.")
+			return err
+		}
+
+		for _, metric := range metrics {
+			select {
+			case metricWatcher <- metric:
+			case <-ctx.Done():
+				return nil
+			}
+			lastID = metric.ID
+		}
+
+		if finished {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// mergeMetrics combines the final metrics from the sys-metrics.json artifact with the latest pushed metrics.
+// Final values take precedence. Pushed metrics that are not in the artifact are appended, sorted by name.
+func mergeMetrics(final, pushed []*Metric) []*Metric {
+	result := make([]*Metric, 0, len(final)+len(pushed))
+	names := make(map[string]bool)
+	for _, metric := range final {
+		names[metric.Name] = true
+		result = append(result, metric)
+	}
+
+	extra := make([]*Metric, 0)
+	for _, metric := range pushed {
+		if names[metric.Name] {
+			continue
+		}
+		extra = append(extra, metric)
+	}
+	sort.Slice(extra, func(i, j int) bool {
+		return extra[i].Name < extra[j].Name
+	})
+
+	return append(result, extra...)
+}
+
+// GetWorkflowExecutionMetrics returns the metrics of the pod.
+// The values in the sys-metrics.json artifact, written when the pod finishes, are merged with the latest pushed values.
+func (c *Client) GetWorkflowExecutionMetrics(namespace, uid, podName string) (metrics []*Metric, err error) {
+	final, artifactErr := c.getWorkflowExecutionMetricsArtifact(namespace, uid, podName)
+
+	pushed, err := c.getLatestWorkflowExecutionMetrics(namespace, uid, podName)
+	if err != nil {
+		if artifactErr != nil {
+			return nil, artifactErr
+		}
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"PodName":   podName,
+			"Error":     err.Error(),
+		}).Error("Unable to get pushed metrics.")
+		pushed = nil
+	}
+
+	if artifactErr != nil && len(pushed) == 0 {
+		return nil, artifactErr
+	}
+
+	return mergeMetrics(final, pushed), nil
+}
+
+// getWorkflowExecutionPodNames returns the names of the pods of the workflow execution that may have metrics.
+// Pods are ordered by when they finished. Pods that only pushed metrics, e.g. after the Argo workflow was deleted, come last.
+func (c *Client) getWorkflowExecutionPodNames(namespace, uid string) (podNames []string, err error) {
+	podNames = make([]string, 0)
+	seen := make(map[string]bool)
+
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil {
+		nodes := make([]wfv1.NodeStatus, 0)
+		for _, node := range wf.Status.Nodes {
+			// Skip the templates injected by onepanel, they do not produce metrics
+			if node.Type != wfv1.NodeTypePod || strings.HasPrefix(node.TemplateName, "sys-") {
+				continue
+			}
+			nodes = append(nodes, node)
+		}
+		sort.Slice(nodes, func(i, j int) bool {
+			if nodes[i].FinishedAt.Equal(&nodes[j].FinishedAt) {
+				return nodes[i].ID < nodes[j].ID
+			}
+			return nodes[i].FinishedAt.Before(&nodes[j].FinishedAt)
+		})
+
+		for _, node := range nodes {
+			seen[node.ID] = true
+			podNames = append(podNames, node.ID)
+		}
+	}
+
+	query := sb.Select("DISTINCT m.pod_name").
+		From("workflow_execution_metrics m").
+		Join("workflow_executions we ON we.id = m.workflow_execution_id").
+		Where(sq.Eq{
+			"we.namespace": namespace,
+			"we.uid":       uid,
+		}).
+		OrderBy("m.pod_name")
+
+	pushedPodNames := make([]string, 0)
+	if err := c.DB.Selectx(&pushedPodNames, query); err != nil {
+		return nil, err
+	}
+	for _, podName := range pushedPodNames {
+		if !seen[podName] {
+			podNames = append(podNames, podName)
+		}
+	}
+
+	return podNames, nil
+}
+
+// getWorkflowExecutionFinalMetrics returns the metrics of all the pods of the workflow execution, sorted by name.
+// If several pods report the same metric, the value of the pod that finished last is used.
+func (c *Client) getWorkflowExecutionFinalMetrics(namespace, uid string) (metrics []*Metric, err error) {
+	podNames, err := c.getWorkflowExecutionPodNames(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	metricsByName := make(map[string]*Metric)
+	for _, podName := range podNames {
+		podMetrics, err := c.GetWorkflowExecutionMetrics(namespace, uid, podName)
+		if err != nil {
+			// Most pods don't report metrics
+			continue
+		}
+		for _, metric := range podMetrics {
+			metricsByName[metric.Name] = metric
+		}
+	}
+
+	metrics = make([]*Metric, 0, len(metricsByName))
+	for _, metric := range metricsByName {
+		metrics = append(metrics, metric)
+	}
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].Name < metrics[j].Name
+	})
+
+	return
+}
+
+// CompareWorkflowExecutions returns a page of the executions of the workflow template matching the filter, along with their
+// parameters and final metrics, and the number of executions that match.
+// Only the final metrics stored by ReconcileWorkflowExecutionMetrics are used, so unfinished executions have no metrics.
+// workflowTemplateVersion is ignored if it is empty. If sort is nil, the most recently created executions are first.
+// Otherwise executions are ordered by the metric, and the ones that did not report it are last.
+func (c *Client) CompareWorkflowExecutions(namespace, workflowTemplateUID, workflowTemplateVersion string, paginator *pagination.PaginationRequest, filter *WorkflowExecutionFilter, sort *WorkflowExecutionMetricSort) (comparisons []*WorkflowExecutionComparison, count int, err error) {
+	count, err = c.CountWorkflowExecutions(namespace, workflowTemplateUID, workflowTemplateVersion, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := workflowExecutionsSelectBuilder(namespace, workflowTemplateUID, workflowTemplateVersion, filter).
+		Columns("we.metrics")
+	if sort != nil {
+		direction := "ASC"
+		if sort.Descending {
+			direction = "DESC"
+		}
+		query = query.LeftJoin(`LATERAL (
+				SELECT (m->>'value')::float AS value
+				FROM jsonb_array_elements(CASE WHEN jsonb_typeof(we.metrics) = 'array' THEN we.metrics ELSE '[]'::jsonb END) m
+				WHERE m->>'name' = ?
+				LIMIT 1
+			) sort_metric ON true`, sort.Metric).
+			OrderBy("sort_metric.value " + direction + " NULLS LAST")
+	}
+	query = query.OrderBy("we.created_at DESC", "we.id DESC")
+	query = *paginator.ApplyToSelect(&query)
+
+	workflowExecutions := make([]*WorkflowExecution, 0)
+	if err := c.DB.Selectx(&workflowExecutions, query); err != nil {
+		return nil, 0, err
+	}
+
+	comparisons = make([]*WorkflowExecutionComparison, 0, len(workflowExecutions))
+	for _, we := range workflowExecutions {
+		we.Namespace = namespace
+		if _, err := we.LoadParametersFromBytes(); err != nil {
+			return nil, 0, err
+		}
+
+		metrics := make([]*Metric, 0)
+		if we.MetricsBytes != nil {
+			if err := json.Unmarshal(we.MetricsBytes, &metrics); err != nil {
+				return nil, 0, err
+			}
+		}
+		comparisons = append(comparisons, &WorkflowExecutionComparison{
+			WorkflowExecution: we,
+			Metrics:           metrics,
+		})
+	}
+
+	return comparisons, count, nil
+}
+
+// ReconcileWorkflowExecutionMetrics stores the final metrics of finished workflow executions that do not have them yet,
+// so CompareWorkflowExecutions does not read the metrics of every execution's pods.
+// Errors for individual executions are logged and do not stop the others, they are retried by the next call.
+func (c *Client) ReconcileWorkflowExecutionMetrics() error {
+	query := sb.Select("we.id", "we.namespace", "we.uid").
+		From("workflow_executions we").
+		Where(sq.Eq{"we.is_archived": false}).
+		OrderBy("we.finished_at").
+		Limit(100)
+
+	return c.storeMissingWorkflowExecutionMetrics(query)
+}
+
+// storeMissingWorkflowExecutionMetrics stores the final metrics of the finished workflow executions selected by query
+// that do not have them yet. query selects the id, namespace and uid of workflow_executions we.
+func (c *Client) storeMissingWorkflowExecutionMetrics(query sq.SelectBuilder) error {
+	query = query.Where(sq.And{
+		sq.Eq{
+			"we.metrics": nil,
+		},
+		sq.NotEq{
+			"we.finished_at": nil,
+		},
+	})
+
+	workflowExecutions := make([]*WorkflowExecution, 0)
+	if err := c.DB.Selectx(&workflowExecutions, query); err != nil {
+		return err
+	}
+
+	semaphore := make(chan struct{}, getBatchConcurrency())
+	wg := sync.WaitGroup{}
+	for _, we := range workflowExecutions {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(we *WorkflowExecution) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			if err := c.storeWorkflowExecutionMetrics(we); err != nil {
+				log.WithFields(log.Fields{
+					"Namespace": we.Namespace,
+					"UID":       we.UID,
+					"Error":     err.Error(),
+				}).Error("Unable to store workflow execution metrics.")
+			}
+		}(we)
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// storeWorkflowExecutionMetrics reads the final metrics of the finished workflow execution from its pods and stores them
+func (c *Client) storeWorkflowExecutionMetrics(we *WorkflowExecution) error {
+	metrics, err := c.getWorkflowExecutionFinalMetrics(we.Namespace, we.UID)
+	if err != nil {
+		return err
+	}
+
+	metricsJSON, err := json.Marshal(metrics)
+	if err != nil {
+		return err
+	}
+
+	_, err = sb.Update("workflow_executions").
+		Set("metrics", metricsJSON).
+		Where(sq.Eq{"id": we.ID}).
+		RunWith(c.DB).
+		Exec()
+
+	return err
+}
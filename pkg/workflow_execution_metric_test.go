@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"fmt"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util/pagination"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// Test_mergeMetrics makes sure final values take precedence over pushed ones
+func Test_mergeMetrics(t *testing.T) {
+	final := []*Metric{{Name: "accuracy", Value: 0.9}}
+	pushed := []*Metric{{Name: "loss", Value: 0.2}, {Name: "accuracy", Value: 0.8}, {Name: "epoch", Value: 3}}
+
+	result := mergeMetrics(final, pushed)
+	assert.Len(t, result, 3)
+	assert.Equal(t, 0.9, result[0].Value)
+	assert.Equal(t, "epoch", result[1].Name)
+	assert.Equal(t, "loss", result[2].Name)
+}
+
+// TestClient_AddWorkflowExecutionMetrics makes sure the latest pushed values are returned
+func TestClient_AddWorkflowExecutionMetrics(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+	podName := "train-123"
+
+	wt := &WorkflowTemplate{
+		Name:     "test",
+		Manifest: defaultWorkflowTemplate,
+	}
+	wt, _ = c.CreateWorkflowTemplate(namespace, wt)
+	we, _ := c.CreateWorkflowExecution(namespace, &WorkflowExecution{Name: "test"}, wt)
+
+	// Only the pods of the execution can add metrics
+	err := c.AddWorkflowExecutionMetrics(namespace, we.UID, podName, []*WorkflowExecutionMetric{{Name: "loss"}})
+	assert.NotNil(t, err)
+	addTestWorkflowPodNode(t, c, namespace, we.UID, podName)
+
+	err = c.AddWorkflowExecutionMetrics(namespace, we.UID, podName, []*WorkflowExecutionMetric{
+		{Name: "loss", Value: 0.5, Step: 1},
+		{Name: "loss", Value: 0.25, Step: 2},
+	})
+	assert.Nil(t, err)
+
+	metrics, err := c.GetWorkflowExecutionMetrics(namespace, we.UID, podName)
+	assert.Nil(t, err)
+	assert.Len(t, metrics, 1)
+	assert.Equal(t, 0.25, metrics[0].Value)
+
+	err = c.AddWorkflowExecutionMetrics(namespace, "not-exist", podName, []*WorkflowExecutionMetric{{Name: "loss"}})
+	assert.NotNil(t, err)
+}
+
+// TestClient_CompareWorkflowExecutions makes sure every execution is counted and only the stored metrics of finished ones are compared
+func TestClient_CompareWorkflowExecutions(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+
+	wt := &WorkflowTemplate{
+		Name:     "test",
+		Manifest: defaultWorkflowTemplate,
+	}
+	wt, _ = c.CreateWorkflowTemplate(namespace, wt)
+	for i, accuracy := range []float64{0.5, 0.9, 0.7} {
+		we, _ := c.CreateWorkflowExecution(namespace, &WorkflowExecution{Name: fmt.Sprintf("test-%v", i)}, wt)
+		addTestWorkflowPodNode(t, c, namespace, we.UID, "train")
+		err := c.AddWorkflowExecutionMetrics(namespace, we.UID, "train", []*WorkflowExecutionMetric{{Name: "accuracy", Value: accuracy}})
+		assert.Nil(t, err)
+	}
+
+	finishedAt := time.Now().UTC()
+	err := c.updateWorkflowExecutionStatusByName(namespace, "test-1", &WorkflowExecutionStatus{Phase: wfv1.NodeSucceeded, FinishedAt: &finishedAt})
+	assert.Nil(t, err)
+	err = c.ReconcileWorkflowExecutionMetrics()
+	assert.Nil(t, err)
+
+	comparisons, count, err := c.CompareWorkflowExecutions(namespace, wt.UID, "", pagination.Start(2), nil, WorkflowExecutionMetricSortFromString("-accuracy"))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, count)
+	assert.Len(t, comparisons, 2)
+	assert.Equal(t, "test-1", comparisons[0].WorkflowExecution.UID)
+	assert.Equal(t, "test-2", comparisons[1].WorkflowExecution.UID)
+	assert.Empty(t, comparisons[1].Metrics)
+
+	comparisons, count, err = c.CompareWorkflowExecutions(namespace, wt.UID, "", pagination.Start(2), nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, count)
+	assert.Len(t, comparisons, 2)
+	assert.Equal(t, "test-2", comparisons[0].WorkflowExecution.UID)
+
+	var metricsBytes []byte
+	err = c.DB.Get(&metricsBytes, "SELECT metrics FROM workflow_executions WHERE name = 'test-1'")
+	assert.Nil(t, err)
+	assert.Contains(t, string(metricsBytes), "accuracy")
+}
@@ -1,8 +1,16 @@
 package v1
 
 import (
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/pagination"
+	"github.com/onepanelio/core/pkg/util/types"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
 	"testing"
+	"time"
 )
 
 // TestClient_CreateWorkflowExecution tests creating a workflow execution
@@ -26,6 +34,47 @@ func TestClient_CreateWorkflowExecution(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+// TestClient_CreateWorkflowExecution_Labels makes sure callers can not set onepanel.io labels, only the server can
+func TestClient_CreateWorkflowExecution_Labels(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+
+	wt := &WorkflowTemplate{
+		Name:     "test",
+		Manifest: defaultWorkflowTemplate,
+	}
+	wt, _ = c.CreateWorkflowTemplate(namespace, wt)
+
+	we, err := c.CreateWorkflowExecution(namespace, &WorkflowExecution{
+		Name: "forged",
+		Labels: types.JSONLabels{
+			"owner":                     "alice",
+			sweepUIDLabelKey:            "sweep-1",
+			workflowTemplateUIDLabelKey: "other",
+		},
+	}, wt)
+	assert.Nil(t, err)
+	assert.Equal(t, types.JSONLabels{"owner": "alice"}, we.Labels)
+
+	workflow, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(we.Name, metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, wt.UID, workflow.Labels[workflowTemplateUIDLabelKey])
+	assert.NotContains(t, workflow.Labels, sweepUIDLabelKey)
+
+	we, err = c.createLabeledWorkflowExecution(namespace, &WorkflowExecution{Name: "trial"}, wt, map[string]string{
+		sweepUIDLabelKey:   "sweep-1",
+		sweepTrialLabelKey: "0",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "sweep-1", we.Labels[sweepUIDLabelKey])
+
+	workflow, err = c.ArgoprojV1alpha1().Workflows(namespace).Get(we.Name, metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "0", workflow.Labels[sweepTrialLabelKey])
+}
+
 // TestClient_GetWorkflowExecution tests getting a workflow execution that exists
 func TestClient_GetWorkflowExecution(t *testing.T) {
 	c := DefaultTestClient()
@@ -97,3 +146,230 @@ func TestClient_ArchiveWorkflowExecution_Exist(t *testing.T) {
 	err = c.ArchiveWorkflowExecution(namespace, weName)
 	assert.Nil(t, err)
 }
+
+// TestClient_ListWorkflowExecutions_Filter makes sure executions are filtered and counted consistently
+func TestClient_ListWorkflowExecutions_Filter(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+
+	wt := &WorkflowTemplate{
+		Name:     "test",
+		Manifest: defaultWorkflowTemplate,
+	}
+	wt, _ = c.CreateWorkflowTemplate(namespace, wt)
+
+	_, err := c.CreateWorkflowExecution(namespace, &WorkflowExecution{Name: "train-a", Labels: map[string]string{"team": "vision"}}, wt)
+	assert.Nil(t, err)
+	_, err = c.CreateWorkflowExecution(namespace, &WorkflowExecution{Name: "train-b"}, wt)
+	assert.Nil(t, err)
+
+	filter := &WorkflowExecutionFilter{
+		Labels: []*Label{{Key: "team", Value: "vision"}},
+	}
+	workflows, err := c.ListWorkflowExecutions(namespace, wt.UID, "", pagination.Start(), filter, nil)
+	assert.Nil(t, err)
+	assert.Len(t, workflows, 1)
+	assert.Equal(t, "train-a", workflows[0].Name)
+
+	count, err := c.CountWorkflowExecutions(namespace, wt.UID, "", filter)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	filter = &WorkflowExecutionFilter{
+		Phases: []wfv1.NodePhase{wfv1.NodeFailed},
+	}
+	count, err = c.CountWorkflowExecutions(namespace, wt.UID, "", filter)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+}
+
+// TestClient_BatchWorkflowExecutions_Archive makes sure every execution gets a result and is archived
+func TestClient_BatchWorkflowExecutions_Archive(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+
+	wt := &WorkflowTemplate{
+		Name:     "test",
+		Manifest: defaultWorkflowTemplate,
+	}
+	wt, _ = c.CreateWorkflowTemplate(namespace, wt)
+
+	first, _ := c.CreateWorkflowExecution(namespace, &WorkflowExecution{Name: "first"}, wt)
+	second, _ := c.CreateWorkflowExecution(namespace, &WorkflowExecution{Name: "second"}, wt)
+
+	uids, err := c.ListWorkflowExecutionUIDs(namespace, wt.UID, nil)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{first.UID, second.UID}, uids)
+
+	results, err := c.BatchWorkflowExecutions(namespace, WorkflowExecutionBatchArchive, append(uids, first.UID))
+	assert.Nil(t, err)
+	assert.Len(t, results, 2)
+	for _, result := range results {
+		assert.Nil(t, result.Error)
+	}
+
+	count, err := c.CountWorkflowExecutions(namespace, wt.UID, "", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+}
+
+// Test_repairWorkflowExecutionStatus makes sure only missing or out of date values are repaired
+func Test_repairWorkflowExecutionStatus(t *testing.T) {
+	startedAt := time.Now().UTC()
+	finishedAt := startedAt.Add(time.Minute)
+
+	current := &WorkflowExecutionStatus{Phase: wfv1.NodePending}
+	argo := &WorkflowExecutionStatus{Phase: wfv1.NodeSucceeded, StartedAt: &startedAt, FinishedAt: &finishedAt}
+	result := repairWorkflowExecutionStatus(current, argo)
+	assert.NotNil(t, result)
+	assert.Equal(t, wfv1.NodeSucceeded, result.Phase)
+	assert.Equal(t, &finishedAt, result.FinishedAt)
+
+	assert.Nil(t, repairWorkflowExecutionStatus(argo, argo))
+	assert.Nil(t, repairWorkflowExecutionStatus(&WorkflowExecutionStatus{Phase: "Terminated"}, argo))
+
+	// The exit handler finishes before Argo does
+	running := &WorkflowExecutionStatus{Phase: wfv1.NodeRunning, StartedAt: &startedAt}
+	assert.Nil(t, repairWorkflowExecutionStatus(argo, running))
+}
+
+// TestClient_ReconcileWorkflowExecutions_Orphan makes sure executions without an Argo workflow are marked as orphaned
+func TestClient_ReconcileWorkflowExecutions_Orphan(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+
+	wt := &WorkflowTemplate{
+		Name:     "test",
+		Manifest: defaultWorkflowTemplate,
+	}
+	wt, _ = c.CreateWorkflowTemplate(namespace, wt)
+
+	running, _ := c.CreateWorkflowExecution(namespace, &WorkflowExecution{Name: "running"}, wt)
+	orphan, _ := c.CreateWorkflowExecution(namespace, &WorkflowExecution{Name: "orphan"}, wt)
+
+	err := c.ArgoprojV1alpha1().Workflows(namespace).Delete(orphan.Name, nil)
+	assert.Nil(t, err)
+
+	// The store has the running workflow, the deleted one is looked up in Argo
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(running.Name, metav1.GetOptions{})
+	assert.Nil(t, err)
+	workflows := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	assert.Nil(t, workflows.Add(wf))
+
+	report, err := c.ReconcileWorkflowExecutions(workflows)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, report.Checked)
+	assert.Equal(t, 1, report.OrphanCount())
+	assert.Equal(t, orphan.Name, report.Repairs[0].UID)
+	assert.Equal(t, wfv1.NodeError, report.Repairs[0].Current.Phase)
+
+	status, err := c.getWorkflowExecutionStatusByName(namespace, running.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, wfv1.NodePending, status.Phase)
+}
+
+// TestClient_ReconcileDeletedWorkflowExecution makes sure an execution is orphaned as soon as its Argo workflow is deleted
+func TestClient_ReconcileDeletedWorkflowExecution(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+
+	wt := &WorkflowTemplate{
+		Name:     "test",
+		Manifest: defaultWorkflowTemplate,
+	}
+	wt, _ = c.CreateWorkflowTemplate(namespace, wt)
+
+	orphan, _ := c.CreateWorkflowExecution(namespace, &WorkflowExecution{Name: "orphan"}, wt)
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(orphan.Name, metav1.GetOptions{})
+	assert.Nil(t, err)
+
+	repair, err := c.ReconcileDeletedWorkflowExecution(wf)
+	assert.Nil(t, err)
+	assert.True(t, repair.Orphaned)
+	assert.Equal(t, wfv1.NodeError, repair.Current.Phase)
+
+	// Finished executions are left alone
+	repair, err = c.ReconcileDeletedWorkflowExecution(wf)
+	assert.Nil(t, err)
+	assert.Nil(t, repair)
+}
+
+// Test_injectArtifactRepositoryConfig makes sure key only artifacts get the config of the repository named by the template annotation
+func Test_injectArtifactRepositoryConfig(t *testing.T) {
+	namespaceConfig := &NamespaceConfig{
+		ArtifactRepository: ArtifactRepositoryProvider{
+			GCS: &ArtifactRepositoryGCSProvider{Bucket: "raw-data"},
+		},
+		ArtifactRepositories: map[string]*ArtifactRepositoryProvider{
+			"models": {
+				S3: &ArtifactRepositoryS3Provider{Bucket: "models", Endpoint: "minio.local:9000"},
+			},
+		},
+	}
+	template := &wfv1.Template{}
+	template.Metadata.Annotations = map[string]string{
+		artifactRepositoryAnnotationPrefix + "model": "models",
+	}
+
+	provider, err := namespaceConfig.GetArtifactRepository(artifactRepositoryName(template, "model"))
+	assert.Nil(t, err)
+	artifact := &wfv1.Artifact{Name: "model"}
+	artifact.S3 = &wfv1.S3Artifact{Key: "models/latest"}
+	assert.Nil(t, injectArtifactRepositoryConfig(artifact, provider))
+	assert.Equal(t, "models", artifact.S3.Bucket)
+	assert.Equal(t, "minio.local:9000", artifact.S3.Endpoint)
+
+	// The named repository is S3, so a GCS artifact can not be stored in it
+	artifact = &wfv1.Artifact{Name: "model"}
+	artifact.GCS = &wfv1.GCSArtifact{}
+	err = injectArtifactRepositoryConfig(artifact, provider)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).Code)
+
+	// The default repository is GCS, so a key only S3 artifact can not be stored in it
+	provider, err = namespaceConfig.GetArtifactRepository(artifactRepositoryName(template, "logs"))
+	assert.Nil(t, err)
+	artifact = &wfv1.Artifact{Name: "logs"}
+	artifact.S3 = &wfv1.S3Artifact{Key: "logs/latest"}
+	err = injectArtifactRepositoryConfig(artifact, provider)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).Code)
+
+	// S3 artifacts with a bucket do not need the repository's config
+	artifact.S3.Bucket = "logs"
+	assert.Nil(t, injectArtifactRepositoryConfig(artifact, provider))
+	assert.Equal(t, "", artifact.S3.Endpoint)
+}
+
+// Test_workflowArtifactRepository makes sure templates can not name repositories Argo can not use
+func Test_workflowArtifactRepository(t *testing.T) {
+	namespaceConfig := &NamespaceConfig{
+		ArtifactRepository: ArtifactRepositoryProvider{
+			S3: &ArtifactRepositoryS3Provider{Bucket: "raw-data"},
+		},
+		ArtifactRepositories: map[string]*ArtifactRepositoryProvider{
+			"archive": {
+				Azure: &ArtifactRepositoryAzureProvider{Container: "archive"},
+			},
+		},
+	}
+	template := &wfv1.Template{}
+	template.Metadata.Annotations = map[string]string{
+		artifactRepositoryAnnotationPrefix + "model": "archive",
+	}
+
+	provider, err := workflowArtifactRepository(namespaceConfig, template, "logs")
+	assert.Nil(t, err)
+	assert.Equal(t, "raw-data", provider.S3.Bucket)
+
+	_, err = workflowArtifactRepository(namespaceConfig, template, "model")
+	assert.NotNil(t, err)
+}
@@ -2,10 +2,12 @@ package v1
 
 import (
 	"encoding/json"
+	"fmt"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/onepanelio/core/pkg/util/sql"
 	"github.com/onepanelio/core/pkg/util/types"
 	uid2 "github.com/onepanelio/core/pkg/util/uid"
+	"strings"
 	"time"
 )
 
@@ -19,6 +21,7 @@ type WorkflowExecution struct {
 	GenerateName     string
 	Parameters       []Parameter
 	ParametersBytes  []byte `db:"parameters"` // to load from database
+	MetricsBytes     []byte `db:"metrics"`    // final metrics, stored once the execution is finished
 	Manifest         string
 	Phase            wfv1.NodePhase
 	StartedAt        *time.Time        `db:"started_at"`
@@ -59,6 +62,173 @@ type WorkflowExecutionStatus struct {
 	FinishedAt *time.Time     `db:"finished_at" json:"finishedAt"`
 }
 
+// WorkflowExecutionMetric is a metric value pushed by a running workflow step
+type WorkflowExecutionMetric struct {
+	ID        uint64
+	PodName   string `db:"pod_name"`
+	Name      string
+	Value     float64
+	Format    string
+	Step      int64
+	Timestamp time.Time
+}
+
+// WorkflowExecutionComparison is a workflow execution along with its final metrics, used to compare executions
+type WorkflowExecutionComparison struct {
+	WorkflowExecution *WorkflowExecution
+	Metrics           []*Metric
+}
+
+// GetMetric returns the metric with the given name, or nil if the execution did not report it
+func (c *WorkflowExecutionComparison) GetMetric(name string) *Metric {
+	for _, metric := range c.Metrics {
+		if metric.Name == name {
+			return metric
+		}
+	}
+
+	return nil
+}
+
+// WorkflowExecutionMetricSort represents how compared WorkflowExecutions are ordered by one of their metrics
+type WorkflowExecutionMetricSort struct {
+	Metric     string
+	Descending bool
+}
+
+// WorkflowExecutionMetricSortFromString parses a sort spec of the form <metric> or -<metric>, where a leading "-" means descending.
+// An empty string results in nil, which keeps the most recently created first.
+func WorkflowExecutionMetricSortFromString(value string) *WorkflowExecutionMetricSort {
+	if value == "" {
+		return nil
+	}
+
+	result := &WorkflowExecutionMetricSort{
+		Metric: strings.TrimPrefix(value, "-"),
+	}
+	result.Descending = result.Metric != value
+
+	return result
+}
+
+// WorkflowExecutionFilter represents the available ways we can filter WorkflowExecutions
+// Zero values are ignored. If Archived is nil, only non-archived executions are selected.
+type WorkflowExecutionFilter struct {
+	Phases         []wfv1.NodePhase
+	StartedAfter   *time.Time
+	StartedBefore  *time.Time
+	FinishedAfter  *time.Time
+	FinishedBefore *time.Time
+	Labels         []*Label
+	Name           string
+	Archived       *bool
+}
+
+// WorkflowExecutionBatchAction is an action that can be applied to many workflow executions at once
+type WorkflowExecutionBatchAction string
+
+const (
+	WorkflowExecutionBatchTerminate WorkflowExecutionBatchAction = "terminate"
+	WorkflowExecutionBatchArchive   WorkflowExecutionBatchAction = "archive"
+	WorkflowExecutionBatchRetry     WorkflowExecutionBatchAction = "retry"
+)
+
+// WorkflowExecutionBatchResult is the outcome of a batch action for a single workflow execution.
+// Error is nil if the action succeeded.
+type WorkflowExecutionBatchResult struct {
+	UID   string
+	Error error
+}
+
+// WorkflowExecutionRepair records a change the reconciler made to a workflow execution's status.
+// Orphaned is true if the Argo workflow no longer exists.
+type WorkflowExecutionRepair struct {
+	Namespace string
+	UID       string
+	Previous  WorkflowExecutionStatus
+	Current   WorkflowExecutionStatus
+	Orphaned  bool
+}
+
+// WorkflowExecutionReconcileReport is the result of reconciling the workflow_executions table with Argo workflows
+type WorkflowExecutionReconcileReport struct {
+	Checked int
+	Repairs []*WorkflowExecutionRepair
+	Errors  int
+}
+
+// OrphanCount returns the number of repairs that marked a workflow execution as orphaned
+func (r *WorkflowExecutionReconcileReport) OrphanCount() int {
+	count := 0
+	for _, repair := range r.Repairs {
+		if repair.Orphaned {
+			count++
+		}
+	}
+
+	return count
+}
+
+// workflowExecutionSortColumns maps the fields WorkflowExecutions can be sorted by to their database columns
+var workflowExecutionSortColumns = map[string]string{
+	"createdAt":  "we.created_at",
+	"startedAt":  "we.started_at",
+	"finishedAt": "we.finished_at",
+	"name":       "we.name",
+	"phase":      "we.phase",
+}
+
+// WorkflowExecutionSort represents how WorkflowExecutions are ordered
+type WorkflowExecutionSort struct {
+	Field      string
+	Descending bool
+}
+
+// WorkflowExecutionSortFromString parses a sort spec of the form <field> or -<field>, where a leading "-" means descending.
+// An empty string results in the default sort, most recently created first.
+func WorkflowExecutionSortFromString(value string) (*WorkflowExecutionSort, error) {
+	if value == "" {
+		return &WorkflowExecutionSort{Field: "createdAt", Descending: true}, nil
+	}
+
+	result := &WorkflowExecutionSort{
+		Field: strings.TrimPrefix(value, "-"),
+	}
+	result.Descending = result.Field != value
+
+	if _, ok := workflowExecutionSortColumns[result.Field]; !ok {
+		return nil, fmt.Errorf(`unknown sort field "%v"`, result.Field)
+	}
+
+	return result, nil
+}
+
+// OrderBy returns the ORDER BY clauses for the sort.
+// The id is always used as a tie breaker so results are stable across pages.
+func (s *WorkflowExecutionSort) OrderBy() []string {
+	if s == nil {
+		return []string{"we.created_at DESC", "we.id DESC"}
+	}
+
+	column, ok := workflowExecutionSortColumns[s.Field]
+	if !ok {
+		column = "we.created_at"
+	}
+
+	direction := "ASC"
+	if s.Descending {
+		direction = "DESC"
+	}
+
+	return []string{column + " " + direction + " NULLS LAST", "we.id " + direction}
+}
+
+// SupportsPageToken returns true if results in this order can be paginated with page tokens,
+// which are only supported for the default sort, most recently created first.
+func (s *WorkflowExecutionSort) SupportsPageToken() bool {
+	return s == nil || (s.Field == "createdAt" && s.Descending)
+}
+
 // GenerateUID generates a uid from the input name and sets it on the workflow execution
 func (we *WorkflowExecution) GenerateUID(name string) error {
 	result, err := uid2.GenerateUID(name, 63)
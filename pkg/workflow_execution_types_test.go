@@ -0,0 +1,58 @@
+package v1
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// TestWorkflowExecutionSortFromString_Default makes sure an empty sort defaults to most recently created first
+func TestWorkflowExecutionSortFromString_Default(t *testing.T) {
+	sort, err := WorkflowExecutionSortFromString("")
+	assert.Nil(t, err)
+	assert.Equal(t, "createdAt", sort.Field)
+	assert.True(t, sort.Descending)
+}
+
+// TestWorkflowExecutionSortFromString_Direction makes sure a leading "-" sorts in descending order
+func TestWorkflowExecutionSortFromString_Direction(t *testing.T) {
+	sort, err := WorkflowExecutionSortFromString("finishedAt")
+	assert.Nil(t, err)
+	assert.Equal(t, "finishedAt", sort.Field)
+	assert.False(t, sort.Descending)
+
+	sort, err = WorkflowExecutionSortFromString("-name")
+	assert.Nil(t, err)
+	assert.Equal(t, "name", sort.Field)
+	assert.True(t, sort.Descending)
+}
+
+// TestWorkflowExecutionSortFromString_Unknown makes sure unknown fields are rejected
+func TestWorkflowExecutionSortFromString_Unknown(t *testing.T) {
+	sort, err := WorkflowExecutionSortFromString("-id; DROP TABLE workflow_executions")
+	assert.Nil(t, sort)
+	assert.NotNil(t, err)
+}
+
+// TestWorkflowExecutionSort_OrderBy makes sure the id is always used as a tie breaker
+func TestWorkflowExecutionSort_OrderBy(t *testing.T) {
+	var sort *WorkflowExecutionSort
+	assert.Equal(t, []string{"we.created_at DESC", "we.id DESC"}, sort.OrderBy())
+
+	sort = &WorkflowExecutionSort{Field: "startedAt"}
+	assert.Equal(t, []string{"we.started_at ASC NULLS LAST", "we.id ASC"}, sort.OrderBy())
+}
+
+// TestWorkflowExecutionSort_SupportsPageToken makes sure page tokens are only supported for the default sort
+func TestWorkflowExecutionSort_SupportsPageToken(t *testing.T) {
+	var sort *WorkflowExecutionSort
+	assert.True(t, sort.SupportsPageToken())
+
+	sort, _ = WorkflowExecutionSortFromString("")
+	assert.True(t, sort.SupportsPageToken())
+
+	sort, _ = WorkflowExecutionSortFromString("createdAt")
+	assert.False(t, sort.SupportsPageToken())
+
+	sort, _ = WorkflowExecutionSortFromString("-name")
+	assert.False(t, sort.SupportsPageToken())
+}
@@ -406,7 +406,7 @@ func (c *Client) selectWorkflowTemplatesQuery(namespace string, paginator *pagin
 		Column("COUNT(wtv.*) versions, MAX(wtv.id) workflow_template_version_id").
 		Join("workflow_template_versions wtv ON wtv.workflow_template_id = wt.id").
 		GroupBy("wt.id", "wt.created_at", "wt.uid", "wt.name", "wt.is_archived").
-		OrderBy("wt.created_at DESC")
+		OrderBy("wt.created_at DESC", "wt.id DESC")
 
 	if filter != nil && len(filter.Labels) > 0 {
 		labelsJSON, err := LabelsToJSONString(filter.Labels)
@@ -416,7 +416,7 @@ func (c *Client) selectWorkflowTemplatesQuery(namespace string, paginator *pagin
 			sb = sb.Where("wt.labels @> ?", labelsJSON)
 		}
 	}
-	sb = *paginator.ApplyToSelect(&sb)
+	sb = *paginator.ApplyToSelect(&sb, "wt.created_at", "wt.id")
 
 	return
 }
@@ -855,7 +855,7 @@ func (c *Client) ArchiveWorkflowTemplate(namespace, uid string) (archived bool,
 		workflowTemplateName := uid + "-v" + wfTempVer
 
 		for {
-			wfs, err := c.ListWorkflowExecutions(namespace, uid, wfTempVer, &paginator)
+			wfs, err := c.ListWorkflowExecutions(namespace, uid, wfTempVer, &paginator, nil, nil)
 			if err != nil {
 				log.WithFields(log.Fields{
 					"Namespace": namespace,
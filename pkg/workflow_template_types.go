@@ -275,6 +275,6 @@ func (wt *WorkflowTemplate) AddWorkflowTemplateParametersFromAnnotations(spec ma
 // getWorkflowTemplateColumns returns all of the columns for workflowTemplate modified by alias, destination.
 // see formatColumnSelect
 func getWorkflowTemplateColumns(aliasAndDestination ...string) []string {
-	columns := []string{"id", "created_at", "uid", "name", "namespace", "modified_at", "is_archived", "labels"}
+	columns := []string{"id", "created_at", "uid", "name", "namespace", "modified_at", "is_archived", "is_system", "labels"}
 	return sql.FormatColumnSelect(columns, aliasAndDestination...)
 }
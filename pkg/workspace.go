@@ -39,20 +39,31 @@ func workspaceStatusToFieldMap(status *WorkspaceStatus) sq.Eq {
 		"phase":       status.Phase,
 		"modified_at": time.Now().UTC(),
 	}
+	// The reason is set when an action starts and kept until the next one, so a paused workspace shows why it was paused
 	switch status.Phase {
 	case WorkspaceLaunching:
 		fieldMap["paused_at"] = pq.NullTime{}
 		fieldMap["started_at"] = time.Now().UTC()
+		fieldMap["reason"] = status.Reason
 	case WorkspacePausing:
 		fieldMap["started_at"] = pq.NullTime{}
 		fieldMap["paused_at"] = time.Now().UTC()
+		fieldMap["reason"] = status.Reason
 	case WorkspaceUpdating:
 		fieldMap["paused_at"] = pq.NullTime{}
 		fieldMap["updated_at"] = time.Now().UTC()
+		fieldMap["reason"] = status.Reason
+	case WorkspaceResizing:
+		fieldMap["reason"] = status.Reason
+	case WorkspaceRunning:
+		// The workspace is running with its template version, so an upgrade can no longer be rolled back
+		fieldMap["previous_workspace_template_version"] = nil
+		fieldMap["previous_parameters"] = nil
 	case WorkspaceTerminating:
 		fieldMap["started_at"] = pq.NullTime{}
 		fieldMap["paused_at"] = pq.NullTime{}
 		fieldMap["terminated_at"] = time.Now().UTC()
+		fieldMap["reason"] = status.Reason
 	}
 
 	return fieldMap
@@ -178,11 +189,17 @@ func (c *Client) createWorkspace(namespace string, parameters []byte, workspace
 		}
 	}
 
-	_, err = c.CreateWorkflowExecution(namespace, &WorkflowExecution{
-		Parameters: workspace.Parameters,
-	}, workflowTemplate)
-	if err != nil {
-		return nil, err
+	// A cloned workspace is launched once its volumes are copied
+	phase := WorkspaceLaunching
+	if workspace.Status.Phase == WorkspaceCloning {
+		phase = WorkspaceCloning
+	} else {
+		_, err = c.CreateWorkflowExecution(namespace, &WorkflowExecution{
+			Parameters: workspace.Parameters,
+		}, workflowTemplate)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	err = sb.Insert("workspaces").
@@ -191,11 +208,15 @@ func (c *Client) createWorkspace(namespace string, parameters []byte, workspace
 			"name":                       workspace.Name,
 			"namespace":                  namespace,
 			"parameters":                 parameters,
-			"phase":                      WorkspaceLaunching,
+			"phase":                      phase,
+			"reason":                     workspace.Status.Reason,
 			"started_at":                 time.Now().UTC(),
 			"workspace_template_id":      workspace.WorkspaceTemplate.ID,
 			"workspace_template_version": workspace.WorkspaceTemplate.Version,
 			"labels":                     workspace.Labels,
+			"schedule":                   workspace.Schedule,
+			"schedule_checked_at":        time.Now().UTC(),
+			"clone_resources":            workspace.CloneResources,
 		}).
 		Suffix("RETURNING id, created_at").
 		RunWith(c.DB).
@@ -209,6 +230,10 @@ func (c *Client) createWorkspace(namespace string, parameters []byte, workspace
 		return nil, util.NewUserError(codes.Unknown, err.Error())
 	}
 
+	// The workspace is new, so there is no previous phase
+	c.createWorkspacePhaseEvent(&Workspace{ID: workspace.ID}, phase, workspace.Status.Reason)
+	workspace.Status.Phase = phase
+
 	return workspace, nil
 }
 
@@ -271,6 +296,7 @@ func (c *Client) startWorkspace(namespace string, parameters []byte, workspace *
 		SetMap(sq.Eq{
 			"phase":      WorkspaceLaunching,
 			"started_at": time.Now().UTC(),
+			"reason":     "",
 		}).
 		Where(sq.Eq{"id": workspace.ID}).
 		RunWith(c.DB).
@@ -283,6 +309,8 @@ func (c *Client) startWorkspace(namespace string, parameters []byte, workspace *
 		return nil, util.NewUserError(codes.Unknown, err.Error())
 	}
 
+	c.createWorkspacePhaseEvent(workspace, WorkspaceLaunching, "")
+
 	return workspace, nil
 }
 
@@ -329,6 +357,11 @@ func (c *Client) CreateWorkspace(namespace string, workspace *Workspace) (*Works
 	if err != nil || !valid {
 		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
 	}
+	if workspace.Schedule != nil {
+		if err := workspace.Schedule.Validate(); err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+		}
+	}
 
 	workspaceTemplate, err := c.GetWorkspaceTemplate(namespace, workspace.WorkspaceTemplate.UID, workspace.WorkspaceTemplate.Version)
 	if err != nil || workspaceTemplate == nil {
@@ -336,7 +369,10 @@ func (c *Client) CreateWorkspace(namespace string, workspace *Workspace) (*Works
 	}
 	workspace.WorkspaceTemplate = workspaceTemplate
 
-	workspace, err = c.createWorkspace(namespace, parameters, workspace)
+	err = c.withQuota(namespace, 1, 0, parametersNodePool(workspace.Parameters), func() (err error) {
+		workspace, err = c.createWorkspace(namespace, parameters, workspace)
+		return
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -347,7 +383,7 @@ func (c *Client) CreateWorkspace(namespace string, workspace *Workspace) (*Works
 // StartWorkspace starts a workspace
 func (c *Client) StartWorkspace(namespace string, workspace *Workspace) (*Workspace, error) {
 	// If already started and not failed, return an error
-	if workspace.ID != 0 && workspace.Status.Phase != WorkspaceFailedToLaunch {
+	if workspace.ID != 0 && workspace.Status.Phase != WorkspaceFailedToLaunch && workspace.Status.Phase != WorkspaceCloning {
 		return workspace, fmt.Errorf("unable to start a workspace with phase %v", workspace.Status.Phase)
 	}
 
@@ -380,6 +416,11 @@ func (c *Client) StartWorkspace(namespace string, workspace *Workspace) (*Worksp
 	if err != nil || !valid {
 		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
 	}
+	if workspace.Schedule != nil {
+		if err := workspace.Schedule.Validate(); err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+		}
+	}
 
 	workspaceTemplate, err := c.GetWorkspaceTemplate(namespace, workspace.WorkspaceTemplate.UID, workspace.WorkspaceTemplate.Version)
 	if err != nil || workspaceTemplate == nil {
@@ -387,7 +428,16 @@ func (c *Client) StartWorkspace(namespace string, workspace *Workspace) (*Worksp
 	}
 	workspace.WorkspaceTemplate = workspaceTemplate
 
-	workspace, err = c.startWorkspace(namespace, parameters, workspace)
+	// A workspace that is cloning already counts towards the quota, one that failed to launch does not
+	start := func() (err error) {
+		workspace, err = c.startWorkspace(namespace, parameters, workspace)
+		return
+	}
+	if workspace.ID != 0 && workspaceCountsTowardsQuota(workspace.Status.Phase) {
+		err = start()
+	} else {
+		err = c.withQuota(namespace, 1, 0, parametersNodePool(workspace.Parameters), start)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -439,12 +489,15 @@ func (c *Client) UpdateWorkspaceStatus(namespace, uid string, status *WorkspaceS
 		return nil
 	}
 
-	if status.Phase == "Failed" || status.Phase == "Error" {
-		workspace, err := c.GetWorkspace(namespace, uid)
-		if err != nil {
-			return err
-		}
+	workspace, err := c.GetWorkspace(namespace, uid)
+	if err != nil {
+		return err
+	}
+	if workspace == nil {
+		return util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
 
+	if status.Phase == "Failed" || status.Phase == "Error" {
 		if workspace.Status.Phase == WorkspaceLaunching && workspace.Status.PausedAt == nil {
 			status.Phase = WorkspaceFailedToLaunch
 		} else if workspace.Status.Phase == WorkspaceLaunching && workspace.Status.PausedAt != nil {
@@ -474,6 +527,23 @@ func (c *Client) UpdateWorkspaceStatus(namespace, uid string, status *WorkspaceS
 		return util.NewUserError(codes.NotFound, "Workspace not found.")
 	}
 
+	reason := ""
+	if isWorkspaceFailedPhase(status.Phase) {
+		reason = c.getWorkspaceFailureReason(namespace, workspace)
+	}
+	c.createWorkspacePhaseEvent(workspace, status.Phase, reason)
+
+	// The volumes of a cloned workspace are bound once it is running, so the snapshots they were restored from are no longer needed
+	if status.Phase == WorkspaceRunning && workspace.CloneResources {
+		c.finishWorkspaceClone(namespace, uid)
+	}
+
+	if workspace.PreviousWorkspaceTemplateVersion != nil &&
+		(status.Phase == WorkspaceFailedToUpdate || status.Phase == WorkspaceFailedToResume) {
+		workspace.Status.Phase = status.Phase
+		return c.rollbackWorkspaceUpgrade(namespace, workspace, reason)
+	}
+
 	return
 }
 
@@ -507,7 +577,7 @@ func (c *Client) ListWorkspaces(namespace string, paginator *pagination.Paginati
 		Columns(getWorkspaceTemplateColumns("wt", "workspace_template")...).
 		From("workspaces w").
 		Join("workspace_templates wt ON wt.id = w.workspace_template_id").
-		OrderBy("w.created_at DESC").
+		OrderBy("w.created_at DESC", "w.id DESC").
 		Where(sq.And{
 			sq.Eq{
 				"w.namespace": namespace,
@@ -516,7 +586,7 @@ func (c *Client) ListWorkspaces(namespace string, paginator *pagination.Paginati
 				"phase": WorkspaceTerminated,
 			},
 		})
-	sb = *paginator.ApplyToSelect(&sb)
+	sb = *paginator.ApplyToSelect(&sb, "w.created_at", "w.id")
 
 	if err := c.DB.Selectx(&workspaces, sb); err != nil {
 		return nil, err
@@ -555,6 +625,13 @@ func (c *Client) updateWorkspace(namespace, uid, workspaceAction, resourceAction
 		return util.NewUserError(codes.NotFound, "Workspace not found.")
 	}
 
+	return c.runWorkspaceAction(namespace, workspace, workspace.WorkspaceTemplate.Version, workspaceAction, resourceAction, status, nil, parameters...)
+}
+
+// runWorkspaceAction runs the action with the workflow template of the workspace template version and updates the workspace to the indicated status.
+// fields are additional columns that are updated with the status.
+func (c *Client) runWorkspaceAction(namespace string, workspace *Workspace, version int64, workspaceAction, resourceAction string, status *WorkspaceStatus, fields sq.Eq, parameters ...Parameter) (err error) {
+	uid := workspace.UID
 	config, err := c.GetSystemConfig()
 	if err != nil {
 		return
@@ -574,9 +651,8 @@ func (c *Client) updateWorkspace(namespace, uid, workspaceAction, resourceAction
 		return
 	}
 
-	workspaceTemplate, err := c.GetWorkspaceTemplate(namespace,
-		workspace.WorkspaceTemplate.UID, workspace.WorkspaceTemplate.Version)
-	if err != nil {
+	workspaceTemplate, err := c.GetWorkspaceTemplate(namespace, workspace.WorkspaceTemplate.UID, version)
+	if err != nil || workspaceTemplate == nil {
 		return util.NewUserError(codes.NotFound, "Workspace template not found.")
 	}
 
@@ -603,25 +679,81 @@ func (c *Client) updateWorkspace(namespace, uid, workspaceAction, resourceAction
 
 	// Update parameters if they are passed in
 	if len(parameters) != 0 {
-		sb.Set("parameters", parametersJSON)
+		sb = sb.Set("parameters", parametersJSON)
+	}
+	if len(fields) != 0 {
+		sb = sb.SetMap(fields)
 	}
 
 	_, err = sb.RunWith(c.DB).
 		Exec()
+	if err != nil {
+		return
+	}
+
+	c.createWorkspacePhaseEvent(workspace, status.Phase, status.Reason)
+	if len(parameters) != 0 {
+		c.createWorkspaceEvent(&WorkspaceEvent{
+			WorkspaceID: workspace.ID,
+			Type:        WorkspaceEventParametersUpdated,
+			Phase:       status.Phase,
+			Details: map[string]interface{}{
+				"parameters": parameters,
+			},
+		})
+	}
 
 	return
 }
 
+// UpdateWorkspace updates the workspace with the parameters, if the namespace's quota allows its node pool
 func (c *Client) UpdateWorkspace(namespace, uid string, parameters []Parameter) (err error) {
-	return c.updateWorkspace(namespace, uid, "update", "apply", &WorkspaceStatus{Phase: WorkspaceUpdating}, parameters...)
+	workspace, err := c.GetWorkspace(namespace, uid)
+	if err != nil {
+		return util.NewUserError(codes.Unknown, err.Error())
+	}
+	if workspace == nil {
+		return util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+
+	currentParameters := workspace.Parameters
+	return c.withWorkspaceQuota(namespace, workspace.Status.Phase, currentParameters, mergeWorkspaceParameters(currentParameters, parameters), func() error {
+		return c.runWorkspaceAction(namespace, workspace, workspace.WorkspaceTemplate.Version, "update", "apply", &WorkspaceStatus{Phase: WorkspaceUpdating}, nil, parameters...)
+	})
 }
 
 func (c *Client) PauseWorkspace(namespace, uid string) (err error) {
 	return c.updateWorkspace(namespace, uid, "pause", "delete", &WorkspaceStatus{Phase: WorkspacePausing})
 }
 
+// ResumeWorkspace launches the workspace again, if the namespace's quota allows it
 func (c *Client) ResumeWorkspace(namespace, uid string) (err error) {
-	return c.updateWorkspace(namespace, uid, "create", "apply", &WorkspaceStatus{Phase: WorkspaceLaunching})
+	return c.resumeWorkspace(namespace, uid, "")
+}
+
+// resumeWorkspace launches the workspace again, if the namespace's quota allows it. reason is why it is resumed.
+func (c *Client) resumeWorkspace(namespace, uid, reason string) (err error) {
+	workspace, err := c.GetWorkspace(namespace, uid)
+	if err != nil {
+		return util.NewUserError(codes.Unknown, err.Error())
+	}
+	if workspace == nil {
+		return util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+	if workspace.Status.Phase == WorkspaceRestoring {
+		return util.NewUserError(codes.FailedPrecondition, "Workspace can not be resumed while a snapshot is restored.")
+	}
+
+	sizeParameters, err := c.workspaceVolumeSizeParameters(namespace, workspace)
+	if err != nil {
+		return err
+	}
+
+	// A workspace that failed to pause already counts towards the quota
+	return c.withWorkspaceQuota(namespace, workspace.Status.Phase, workspace.Parameters, workspace.Parameters, func() error {
+		status := &WorkspaceStatus{Phase: WorkspaceLaunching, Reason: reason}
+		return c.runWorkspaceAction(namespace, workspace, workspace.WorkspaceTemplate.Version, "create", "apply", status, nil, sizeParameters...)
+	})
 }
 
 func (c *Client) DeleteWorkspace(namespace, uid string) (err error) {
@@ -0,0 +1,404 @@
+package v1
+
+import (
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/env"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"strings"
+)
+
+// workspaceCloneLabelKey labels the volumes, snapshots and copy jobs of a cloned workspace, its value is the clone's uid
+const workspaceCloneLabelKey = "onepanel.io/workspace-clone"
+
+// workspaceVolumeCopyImage is the image of the jobs that copy volumes which can not be snapshotted
+var workspaceVolumeCopyImage = env.GetEnv("WORKSPACE_VOLUME_COPY_IMAGE", "alpine:3.12")
+
+// workspaceVolumeClaimName is the name of the PersistentVolumeClaim the workspace's StatefulSet creates for the volume
+func workspaceVolumeClaimName(volumeName, uid string) string {
+	return fmt.Sprintf("%v-%v-0", volumeName, uid)
+}
+
+// listWorkspaceVolumeClaims returns the PersistentVolumeClaims of the workspace by the name of their volume
+func (c *Client) listWorkspaceVolumeClaims(namespace, uid string) (map[string]corev1.PersistentVolumeClaim, error) {
+	claimList, err := c.CoreV1().PersistentVolumeClaims(namespace).List(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", workspacePodLabelKey, uid),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	claims := make(map[string]corev1.PersistentVolumeClaim)
+	suffix := workspaceVolumeClaimName("", uid)
+	for _, claim := range claimList.Items {
+		if !strings.HasSuffix(claim.Name, suffix) {
+			continue
+		}
+		claims[strings.TrimSuffix(claim.Name, suffix)] = claim
+	}
+
+	return claims, nil
+}
+
+// newWorkspaceVolumeClaim returns a claim for the volume of the workspace with the uid with the same spec as the source claim.
+// The workspace's StatefulSet uses it instead of creating one from its volumeClaimTemplate.
+func newWorkspaceVolumeClaim(source *corev1.PersistentVolumeClaim, volumeName, uid string) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: workspaceVolumeClaimName(volumeName, uid),
+			Labels: map[string]string{
+				workspacePodLabelKey: uid,
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      source.Spec.AccessModes,
+			StorageClassName: source.Spec.StorageClassName,
+			VolumeMode:       source.Spec.VolumeMode,
+			Resources: corev1.ResourceRequirements{
+				Requests: source.Spec.Resources.Requests,
+			},
+		},
+	}
+}
+
+// newWorkspaceVolumeCopyJob returns a job that copies the files of the source claim to the destination claim.
+// If nodeName is set, the job runs on that node, so it can mount a ReadWriteOnce volume that is in use.
+func newWorkspaceVolumeCopyJob(sourceClaimName, destinationClaimName, uid, nodeName string) *batchv1.Job {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: destinationClaimName + "-copy",
+			Labels: map[string]string{
+				workspaceCloneLabelKey: uid,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.Int32(3),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:    "copy",
+							Image:   workspaceVolumeCopyImage,
+							Command: []string{"sh", "-c", "cp -a /source/. /destination/"},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "source", MountPath: "/source", ReadOnly: true},
+								{Name: "destination", MountPath: "/destination"},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "source",
+							VolumeSource: corev1.VolumeSource{
+								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: sourceClaimName,
+									ReadOnly:  true,
+								},
+							},
+						},
+						{
+							Name: "destination",
+							VolumeSource: corev1.VolumeSource{
+								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: destinationClaimName,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if nodeName != "" {
+		job.Spec.Template.Spec.NodeSelector = map[string]string{
+			"kubernetes.io/hostname": nodeName,
+		}
+	}
+
+	return job
+}
+
+// CloneWorkspace creates workspace with the workspace template version and parameters of the source workspace,
+// and volumes that start with the data of the source's volumes.
+// Volumes are restored from VolumeSnapshots if their storage class supports them, otherwise they are copied by jobs
+// and the workspace stays in the Cloning phase until the copies are done.
+func (c *Client) CloneWorkspace(namespace, sourceUID string, workspace *Workspace) (*Workspace, error) {
+	source, err := c.GetWorkspace(namespace, sourceUID)
+	if err != nil {
+		return nil, util.NewUserError(codes.Unknown, err.Error())
+	}
+	if source == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+	if source.Status.Phase != WorkspaceRunning && source.Status.Phase != WorkspacePaused {
+		return nil, util.NewUserError(codes.FailedPrecondition, "Only running or paused workspaces can be cloned.")
+	}
+
+	if err := workspace.GenerateUID(workspace.Name); err != nil {
+		return nil, err
+	}
+	existingWorkspace, err := c.GetWorkspace(namespace, workspace.UID)
+	if err != nil {
+		return nil, err
+	}
+	if existingWorkspace != nil {
+		return nil, util.NewUserError(codes.AlreadyExists, "Workspace already exists.")
+	}
+
+	workspace.WorkspaceTemplate = &WorkspaceTemplate{
+		UID:     source.WorkspaceTemplate.UID,
+		Version: source.WorkspaceTemplate.Version,
+	}
+	workspace.Parameters = mergeWorkspaceParameters(source.Parameters, []Parameter{
+		{
+			Name:  "sys-name",
+			Value: ptr.String(workspace.Name),
+		},
+	})
+
+	workspace.CloneResources = true
+
+	uid := workspace.UID
+	copying, err := c.cloneWorkspaceVolumes(namespace, source, uid)
+	if err != nil {
+		c.deleteWorkspaceCloneResources(namespace, uid, true)
+		return nil, err
+	}
+	if copying {
+		workspace.Status = WorkspaceStatus{
+			Phase:  WorkspaceCloning,
+			Reason: fmt.Sprintf("Copying volumes from %v.", source.Name),
+		}
+	}
+
+	workspace, err = c.CreateWorkspace(namespace, workspace)
+	if err != nil {
+		c.deleteWorkspaceCloneResources(namespace, uid, true)
+		return nil, err
+	}
+
+	return workspace, nil
+}
+
+// cloneWorkspaceVolumes creates the volumes of the clone with the uid from the volumes of the source workspace.
+// copying is true if any of them are copied by jobs.
+func (c *Client) cloneWorkspaceVolumes(namespace string, source *Workspace, uid string) (copying bool, err error) {
+	claims, err := c.listWorkspaceVolumeClaims(namespace, source.UID)
+	if err != nil {
+		return false, err
+	}
+
+	// A volume in use can only be copied on the node of the workspace's pod
+	nodeName := ""
+	if source.Status.Phase == WorkspaceRunning {
+		pods, err := c.listWorkspacePods(namespace, source.UID)
+		if err != nil {
+			return false, err
+		}
+		if len(pods) > 0 {
+			nodeName = pods[0].Spec.NodeName
+		}
+	}
+
+	for volumeName, sourceClaim := range claims {
+		claim := newWorkspaceVolumeClaim(&sourceClaim, volumeName, uid)
+		claim.Labels[workspaceCloneLabelKey] = uid
+
+		className, err := c.getVolumeSnapshotClassName(sourceClaim.Spec.StorageClassName)
+		if err != nil {
+			return false, err
+		}
+		if className != "" {
+			snapshotName := claim.Name + "-clone"
+			err = c.createVolumeSnapshot(namespace, snapshotName, className, sourceClaim.Name, map[string]string{
+				workspaceCloneLabelKey: uid,
+			})
+			if err != nil {
+				return false, err
+			}
+			claim.Spec.DataSource = volumeSnapshotDataSource(snapshotName)
+		}
+
+		if _, err := c.CoreV1().PersistentVolumeClaims(namespace).Create(claim); err != nil {
+			return false, err
+		}
+
+		if className == "" {
+			job := newWorkspaceVolumeCopyJob(sourceClaim.Name, claim.Name, uid, nodeName)
+			if _, err := c.BatchV1().Jobs(namespace).Create(job); err != nil {
+				return false, err
+			}
+			copying = true
+		}
+	}
+
+	return
+}
+
+// deleteWorkspaceCloneResources deletes the snapshots and copy jobs that populated the volumes of the clone with the uid.
+// If volumes is set, the clone's volumes are deleted too. Every error is logged, and the last one is returned.
+func (c *Client) deleteWorkspaceCloneResources(namespace, uid string, volumes bool) (err error) {
+	listOptions := metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", workspaceCloneLabelKey, uid),
+	}
+	propagationPolicy := metav1.DeletePropagationBackground
+	deleteOptions := &metav1.DeleteOptions{
+		PropagationPolicy: &propagationPolicy,
+	}
+
+	errs := make([]error, 0)
+	err = c.Dynamic().Resource(volumeSnapshotResource).Namespace(namespace).DeleteCollection(deleteOptions, listOptions)
+	if err != nil && !errors.IsNotFound(err) {
+		errs = append(errs, err)
+	}
+	if err := c.BatchV1().Jobs(namespace).DeleteCollection(deleteOptions, listOptions); err != nil {
+		errs = append(errs, err)
+	}
+	if volumes {
+		if err := c.CoreV1().PersistentVolumeClaims(namespace).DeleteCollection(deleteOptions, listOptions); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, err := range errs {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Unable to delete workspace clone resources.")
+	}
+	if len(errs) > 0 {
+		return errs[len(errs)-1]
+	}
+
+	return nil
+}
+
+// finishWorkspaceClone deletes the snapshots and copy jobs of the clone with the uid, and marks it as not having them
+// once they are deleted. If deleting fails, it is tried again the next time the workspace is running.
+func (c *Client) finishWorkspaceClone(namespace, uid string) {
+	if err := c.deleteWorkspaceCloneResources(namespace, uid, false); err != nil {
+		return
+	}
+
+	_, err := sb.Update("workspaces").
+		Set("clone_resources", false).
+		Where(sq.Eq{
+			"namespace": namespace,
+			"uid":       uid,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Unable to update workspace clone resources.")
+	}
+}
+
+// workspaceVolumeCopyStatus returns whether every copy job completed, and the reason if one of them failed
+func workspaceVolumeCopyStatus(jobs []batchv1.Job) (complete bool, failureReason string) {
+	if len(jobs) == 0 {
+		return false, "Volume copy jobs not found."
+	}
+
+	complete = true
+	for _, job := range jobs {
+		jobComplete := false
+		for _, condition := range job.Status.Conditions {
+			if condition.Status != corev1.ConditionTrue {
+				continue
+			}
+			if condition.Type == batchv1.JobFailed {
+				return false, fmt.Sprintf("Unable to copy volume: %v", condition.Message)
+			}
+			if condition.Type == batchv1.JobComplete {
+				jobComplete = true
+			}
+		}
+		complete = complete && jobComplete
+	}
+
+	return
+}
+
+// ReconcileWorkspaceClones launches the cloned workspaces whose volumes have been copied.
+// If a copy fails, the workspace fails to launch.
+func (c *Client) ReconcileWorkspaceClones() error {
+	query := sb.Select("namespace", "uid").
+		From("workspaces").
+		Where(sq.Eq{
+			"phase": WorkspaceCloning,
+		}).
+		OrderBy("id")
+
+	workspaces := make([]*Workspace, 0)
+	if err := c.DB.Selectx(&workspaces, query); err != nil {
+		return err
+	}
+
+	for _, w := range workspaces {
+		if err := c.reconcileWorkspaceClone(w.Namespace, w.UID); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": w.Namespace,
+				"UID":       w.UID,
+				"Error":     err.Error(),
+			}).Error("Unable to reconcile workspace clone.")
+		}
+	}
+
+	return nil
+}
+
+// reconcileWorkspaceClone launches the cloned workspace if its volumes have been copied
+func (c *Client) reconcileWorkspaceClone(namespace, uid string) error {
+	jobList, err := c.BatchV1().Jobs(namespace).List(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", workspaceCloneLabelKey, uid),
+	})
+	if err != nil {
+		return err
+	}
+
+	complete, failureReason := workspaceVolumeCopyStatus(jobList.Items)
+	if !complete && failureReason == "" {
+		return nil
+	}
+
+	workspace, err := c.GetWorkspace(namespace, uid)
+	if err != nil {
+		return err
+	}
+	if workspace == nil || workspace.Status.Phase != WorkspaceCloning {
+		return nil
+	}
+
+	c.finishWorkspaceClone(namespace, uid)
+
+	if failureReason != "" {
+		_, err := updateWorkspaceStatusBuilder(namespace, uid, &WorkspaceStatus{Phase: WorkspaceFailedToLaunch}).
+			Set("reason", failureReason).
+			RunWith(c.DB).
+			Exec()
+		if err != nil {
+			return err
+		}
+		c.createWorkspacePhaseEvent(workspace, WorkspaceFailedToLaunch, failureReason)
+
+		return nil
+	}
+
+	_, err = c.StartWorkspace(namespace, workspace)
+
+	return err
+}
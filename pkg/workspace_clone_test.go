@@ -0,0 +1,233 @@
+package v1
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"testing"
+)
+
+// Test_listWorkspaceVolumeClaims makes sure only the claims of the workspace are returned, by volume name
+func Test_listWorkspaceVolumeClaims(t *testing.T) {
+	c := &Client{
+		Interface: fake.NewSimpleClientset(
+			newTestVolumeClaim("data", "jupyterlab"),
+			newTestVolumeClaim("sys-home", "jupyterlab"),
+			newTestVolumeClaim("data", "vscode"),
+		),
+	}
+
+	claims, err := c.listWorkspaceVolumeClaims("onepanel", "jupyterlab")
+	assert.Nil(t, err)
+	assert.Len(t, claims, 2)
+	assert.Equal(t, "data-jupyterlab-0", claims["data"].Name)
+	assert.Equal(t, "sys-home-jupyterlab-0", claims["sys-home"].Name)
+}
+
+func Test_workspaceVolumeCopyStatus(t *testing.T) {
+	complete, reason := workspaceVolumeCopyStatus(nil)
+	assert.False(t, complete)
+	assert.NotEmpty(t, reason)
+
+	complete, reason = workspaceVolumeCopyStatus([]batchv1.Job{
+		*newTestCopyJob("copy-1", "jupyterlab", batchv1.JobComplete),
+		*newTestCopyJob("copy-2", "jupyterlab", ""),
+	})
+	assert.False(t, complete)
+	assert.Empty(t, reason)
+
+	complete, reason = workspaceVolumeCopyStatus([]batchv1.Job{
+		*newTestCopyJob("copy-3", "jupyterlab", batchv1.JobComplete),
+		*newTestCopyJob("copy-4", "jupyterlab", batchv1.JobComplete),
+	})
+	assert.True(t, complete)
+	assert.Empty(t, reason)
+
+	complete, reason = workspaceVolumeCopyStatus([]batchv1.Job{
+		*newTestCopyJob("copy-5", "jupyterlab", batchv1.JobComplete),
+		*newTestCopyJob("copy-6", "jupyterlab", batchv1.JobFailed),
+	})
+	assert.False(t, complete)
+	assert.NotEmpty(t, reason)
+}
+
+// newTestStorageClass creates a StorageClass of the provisioner
+func newTestStorageClass(name, provisioner string) *storagev1.StorageClass {
+	return &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Provisioner: provisioner,
+	}
+}
+
+// newTestCloneSource returns the claims of the volumes of the jupyterlab workspace, data can be snapshotted and home can not,
+// and the pod the workspace runs in
+func newTestCloneSource() []runtime.Object {
+	data := newTestVolumeClaim("data", "jupyterlab")
+	data.Spec.StorageClassName = ptr.String("csi")
+	home := newTestVolumeClaim("home", "jupyterlab")
+	home.Spec.StorageClassName = ptr.String("standard")
+	pod := newTestPod("jupyterlab-0", "jupyterlab")
+	pod.Spec.NodeName = "node-1"
+
+	return []runtime.Object{
+		data,
+		home,
+		pod,
+		newTestStorageClass("csi", "ebs.csi.aws.com"),
+		newTestStorageClass("standard", "kubernetes.io/aws-ebs"),
+	}
+}
+
+// Test_cloneWorkspaceVolumes makes sure volumes are restored from snapshots if their storage class supports them,
+// and are otherwise copied by a job on the node of the running workspace
+func Test_cloneWorkspaceVolumes(t *testing.T) {
+	c := &Client{
+		Interface: fake.NewSimpleClientset(newTestCloneSource()...),
+		dynamic:   dynamicFake.NewSimpleDynamicClient(runtime.NewScheme(), newTestVolumeSnapshotClass("ebs", "ebs.csi.aws.com", false)),
+	}
+	source := &Workspace{UID: "jupyterlab", Status: WorkspaceStatus{Phase: WorkspaceRunning}}
+
+	copying, err := c.cloneWorkspaceVolumes("onepanel", source, "jupyterlab-copy")
+	assert.Nil(t, err)
+	assert.True(t, copying)
+
+	data, err := c.CoreV1().PersistentVolumeClaims("onepanel").Get("data-jupyterlab-copy-0", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "jupyterlab-copy", data.Labels[workspaceCloneLabelKey])
+	if assert.NotNil(t, data.Spec.DataSource) {
+		assert.Equal(t, "data-jupyterlab-copy-0-clone", data.Spec.DataSource.Name)
+	}
+	_, err = c.Dynamic().Resource(volumeSnapshotResource).Namespace("onepanel").Get("data-jupyterlab-copy-0-clone", metav1.GetOptions{})
+	assert.Nil(t, err)
+
+	home, err := c.CoreV1().PersistentVolumeClaims("onepanel").Get("home-jupyterlab-copy-0", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Nil(t, home.Spec.DataSource)
+
+	jobs, err := c.BatchV1().Jobs("onepanel").List(metav1.ListOptions{})
+	assert.Nil(t, err)
+	if assert.Len(t, jobs.Items, 1) {
+		job := jobs.Items[0]
+		assert.Equal(t, "home-jupyterlab-copy-0-copy", job.Name)
+		assert.Equal(t, "node-1", job.Spec.Template.Spec.NodeSelector["kubernetes.io/hostname"])
+		assert.Equal(t, "home-jupyterlab-0", job.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+		assert.Equal(t, "home-jupyterlab-copy-0", job.Spec.Template.Spec.Volumes[1].PersistentVolumeClaim.ClaimName)
+	}
+}
+
+// countDeleteCollectionActions counts the DeleteCollection requests the fake clientset received
+func countDeleteCollectionActions(clientset *fake.Clientset) (count int) {
+	for _, action := range clientset.Actions() {
+		if _, ok := action.(k8stesting.DeleteCollectionAction); ok {
+			count++
+		}
+	}
+
+	return
+}
+
+// createTestCloneSource creates the template and the running jupyterlab workspace of newTestCloneSource
+func createTestCloneSource(t *testing.T, c *Client) *Workspace {
+	workspaceTemplate, err := c.CreateWorkspaceTemplate("onepanel", &WorkspaceTemplate{
+		Name:     "test",
+		Manifest: jupyterLabWorkspaceManifest,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	source := &Workspace{
+		Name:              "jupyterlab",
+		WorkspaceTemplate: workspaceTemplate,
+	}
+	if err := source.GenerateUID(source.Name); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.createWorkspace("onepanel", []byte("[]"), source); err != nil {
+		t.Fatal(err)
+	}
+	_, err = updateWorkspaceStatusBuilder("onepanel", source.UID, &WorkspaceStatus{Phase: WorkspaceRunning}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return source
+}
+
+// TestClient_CloneWorkspace makes sure a clone waits for its copied volumes, and fails to launch if a copy fails
+func TestClient_CloneWorkspace(t *testing.T) {
+	c := NewTestClient(database, append(newTestCloneSource(), mockSystemConfigMap, mockSystemSecret)...)
+	clearDatabase(t)
+	createTestCloneSource(t, c)
+
+	clone, err := c.CloneWorkspace("onepanel", "jupyterlab", &Workspace{Name: "jupyterlab-copy"})
+	assert.Nil(t, err)
+	assert.Equal(t, WorkspaceCloning, clone.Status.Phase)
+
+	clone, err = c.GetWorkspace("onepanel", "jupyterlab-copy")
+	assert.Nil(t, err)
+	assert.True(t, clone.CloneResources)
+
+	// The copy is still running
+	assert.Nil(t, c.reconcileWorkspaceClone("onepanel", "jupyterlab-copy"))
+	clone, err = c.GetWorkspace("onepanel", "jupyterlab-copy")
+	assert.Nil(t, err)
+	assert.Equal(t, WorkspaceCloning, clone.Status.Phase)
+
+	job, err := c.BatchV1().Jobs("onepanel").Get("home-jupyterlab-copy-0-copy", metav1.GetOptions{})
+	assert.Nil(t, err)
+	job.Status.Conditions = []batchv1.JobCondition{
+		{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "BackoffLimitExceeded"},
+	}
+	_, err = c.BatchV1().Jobs("onepanel").Update(job)
+	assert.Nil(t, err)
+
+	assert.Nil(t, c.reconcileWorkspaceClone("onepanel", "jupyterlab-copy"))
+	clone, err = c.GetWorkspace("onepanel", "jupyterlab-copy")
+	assert.Nil(t, err)
+	assert.Equal(t, WorkspaceFailedToLaunch, clone.Status.Phase)
+	assert.False(t, clone.CloneResources)
+
+	// Only running or paused workspaces can be cloned
+	_, err = c.CloneWorkspace("onepanel", "jupyterlab-copy", &Workspace{Name: "jupyterlab-copy-2"})
+	assert.NotNil(t, err)
+}
+
+// TestClient_UpdateWorkspaceStatus_Clone makes sure only cloned workspaces delete their clone resources once running
+func TestClient_UpdateWorkspaceStatus_Clone(t *testing.T) {
+	c := NewTestClient(database, mockSystemConfigMap, mockSystemSecret)
+	clearDatabase(t)
+	source := createTestCloneSource(t, c)
+	clientset := c.Interface.(*fake.Clientset)
+
+	clientset.ClearActions()
+	assert.Nil(t, c.UpdateWorkspaceStatus("onepanel", source.UID, &WorkspaceStatus{Phase: WorkspaceRunning}))
+	assert.Equal(t, 0, countDeleteCollectionActions(clientset))
+
+	_, err := sb.Update("workspaces").
+		Set("clone_resources", true).
+		Where(sq.Eq{"uid": source.UID}).
+		RunWith(c.DB).
+		Exec()
+	assert.Nil(t, err)
+
+	clientset.ClearActions()
+	assert.Nil(t, c.UpdateWorkspaceStatus("onepanel", source.UID, &WorkspaceStatus{Phase: WorkspaceRunning}))
+	assert.Equal(t, 1, countDeleteCollectionActions(clientset))
+
+	workspace, err := c.GetWorkspace("onepanel", source.UID)
+	assert.Nil(t, err)
+	assert.False(t, workspace.CloneResources)
+}
@@ -0,0 +1,171 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util/pagination"
+	log "github.com/sirupsen/logrus"
+	"sort"
+)
+
+// createWorkspaceEvent records the event in the workspace's history, setting its actor to the client's.
+// The change the event records has already been made, so errors are only logged.
+func (c *Client) createWorkspaceEvent(event *WorkspaceEvent) {
+	if event.Details == nil {
+		event.Details = make(map[string]interface{})
+	}
+	event.Actor = c.Actor()
+
+	details, err := json.Marshal(event.Details)
+	if err == nil {
+		err = sb.Insert("workspace_events").
+			SetMap(sq.Eq{
+				"workspace_id":   event.WorkspaceID,
+				"type":           event.Type,
+				"phase":          event.Phase,
+				"previous_phase": event.PreviousPhase,
+				"reason":         event.Reason,
+				"details":        details,
+				"actor":          event.Actor,
+			}).
+			Suffix("RETURNING id, created_at").
+			RunWith(c.DB).
+			QueryRow().
+			Scan(&event.ID, &event.CreatedAt)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"WorkspaceID": event.WorkspaceID,
+			"Event":       event,
+			"Error":       err.Error(),
+		}).Error("Unable to record workspace event.")
+	}
+}
+
+// createWorkspacePhaseEvent records that the workspace moved to phase, if it is not already in it.
+// A move to a failed phase is recorded as a WorkspaceEventFailed with the reason.
+func (c *Client) createWorkspacePhaseEvent(workspace *Workspace, phase WorkspacePhase, reason string) {
+	if workspace.Status.Phase == phase {
+		return
+	}
+
+	eventType := WorkspaceEventPhaseChanged
+	if isWorkspaceFailedPhase(phase) {
+		eventType = WorkspaceEventFailed
+	}
+
+	c.createWorkspaceEvent(&WorkspaceEvent{
+		WorkspaceID:   workspace.ID,
+		Type:          eventType,
+		Phase:         phase,
+		PreviousPhase: workspace.Status.Phase,
+		Reason:        reason,
+	})
+}
+
+// latestWorkspaceOf selects the most recent workspace with the uid, which may be terminated.
+// The select must join workspaces w, such as on the workspace_id of its events or snapshots.
+func latestWorkspaceOf(namespace, uid string) sq.Sqlizer {
+	return sq.And{
+		sq.Eq{
+			"w.namespace": namespace,
+			"w.uid":       uid,
+		},
+		sq.Expr("w.id = (SELECT MAX(id) FROM workspaces WHERE namespace = w.namespace AND uid = w.uid)"),
+	}
+}
+
+// ListWorkspaceEvents returns the history of the workspace, most recent first. If eventType is set, only events of that type are returned.
+func (c *Client) ListWorkspaceEvents(namespace, uid string, eventType WorkspaceEventType, paginator *pagination.PaginationRequest) (events []*WorkspaceEvent, err error) {
+	query := sb.Select(getWorkspaceEventColumns("we")...).
+		From("workspace_events we").
+		Join("workspaces w ON w.id = we.workspace_id").
+		Where(latestWorkspaceOf(namespace, uid)).
+		OrderBy("we.created_at DESC", "we.id DESC")
+	if eventType != "" {
+		query = query.Where(sq.Eq{"we.type": eventType})
+	}
+	query = *paginator.ApplyToSelect(&query, "we.created_at", "we.id")
+
+	events = make([]*WorkspaceEvent, 0)
+	if err = c.DB.Selectx(&events, query); err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		if err := event.LoadFromBytes(); err != nil {
+			return nil, err
+		}
+	}
+
+	return
+}
+
+// CountWorkspaceEvents returns the number of events in the workspace's history of the type, or of every type if eventType is empty
+func (c *Client) CountWorkspaceEvents(namespace, uid string, eventType WorkspaceEventType) (count int, err error) {
+	query := sb.Select("COUNT(*)").
+		From("workspace_events we").
+		Join("workspaces w ON w.id = we.workspace_id").
+		Where(latestWorkspaceOf(namespace, uid))
+	if eventType != "" {
+		query = query.Where(sq.Eq{"we.type": eventType})
+	}
+
+	err = query.RunWith(c.DB).
+		QueryRow().
+		Scan(&count)
+
+	return
+}
+
+// workspaceActionFailureReason returns the messages of the steps of the action workflow that failed, in the order they finished.
+// If no step has a message, the workflow's message is returned.
+func workspaceActionFailureReason(wf *wfv1.Workflow) string {
+	failedNodes := make([]wfv1.NodeStatus, 0)
+	for _, node := range wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypePod || node.Message == "" {
+			continue
+		}
+		if node.Phase == wfv1.NodeFailed || node.Phase == wfv1.NodeError {
+			failedNodes = append(failedNodes, node)
+		}
+	}
+
+	if len(failedNodes) == 0 {
+		return wf.Status.Message
+	}
+
+	sort.Slice(failedNodes, func(i, j int) bool {
+		return failedNodes[i].FinishedAt.Before(&failedNodes[j].FinishedAt)
+	})
+
+	reason := ""
+	for i, node := range failedNodes {
+		if i > 0 {
+			reason += "\n"
+		}
+		reason += fmt.Sprintf("%v: %v", node.DisplayName, node.Message)
+	}
+
+	return reason
+}
+
+// getWorkspaceFailureReason returns why the workspace's last action workflow failed, or an empty string if it can not be found
+func (c *Client) getWorkspaceFailureReason(namespace string, workspace *Workspace) string {
+	wf, err := c.getLastWorkspaceActionWorkflow(namespace, workspace)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       workspace.UID,
+			"Error":     err.Error(),
+		}).Error("Unable to get workspace action workflow.")
+		return ""
+	}
+	if wf == nil {
+		return ""
+	}
+
+	return workspaceActionFailureReason(wf)
+}
@@ -0,0 +1,92 @@
+package v1
+
+import (
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"testing"
+	"time"
+)
+
+// TestClient_ListWorkspaceEvents makes sure phase changes are recorded and listed most recent first
+func TestClient_ListWorkspaceEvents(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+
+	workspace := &Workspace{
+		Name: "test",
+		Parameters: []Parameter{
+			{
+				Name:  "workflow-execution-name",
+				Value: ptr.String("test"),
+			},
+		},
+		WorkspaceTemplate: &WorkspaceTemplate{
+			Name:     "test",
+			Manifest: jupyterLabWorkspaceManifest,
+			WorkflowTemplate: &WorkflowTemplate{
+				UID:     "test",
+				Version: 1,
+			},
+		},
+	}
+	workspace.GenerateUID("test")
+
+	c.CreateWorkspaceTemplate(namespace, workspace.WorkspaceTemplate)
+	ws, _ := c.createWorkspace(namespace, []byte("[]"), workspace)
+
+	err := c.UpdateWorkspaceStatus(namespace, ws.UID, &WorkspaceStatus{Phase: WorkspacePausing})
+	assert.Nil(t, err)
+
+	events, err := c.ListWorkspaceEvents(namespace, ws.UID, "", nil)
+	assert.Nil(t, err)
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, WorkspacePausing, events[0].Phase)
+		assert.Equal(t, WorkspaceLaunching, events[0].PreviousPhase)
+		assert.Equal(t, WorkspaceLaunching, events[1].Phase)
+	}
+
+	count, err := c.CountWorkspaceEvents(namespace, ws.UID, WorkspaceEventFailed)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+}
+
+// Test_workspaceActionFailureReason makes sure the messages of failed steps are used, in the order they finished
+func Test_workspaceActionFailureReason(t *testing.T) {
+	finishedAt := time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)
+	wf := &wfv1.Workflow{
+		Status: wfv1.WorkflowStatus{
+			Message: "child failed",
+			Nodes: map[string]wfv1.NodeStatus{
+				"workspace": {
+					Type:    wfv1.NodeTypeDAG,
+					Phase:   wfv1.NodeFailed,
+					Message: "child failed",
+				},
+				"apply-statefulset": {
+					DisplayName: "stateful-set-resource",
+					Type:        wfv1.NodeTypePod,
+					Phase:       wfv1.NodeFailed,
+					Message:     "persistentvolumeclaims exceeded quota",
+					FinishedAt:  metav1.NewTime(finishedAt.Add(time.Minute)),
+				},
+				"apply-service": {
+					DisplayName: "service-resource",
+					Type:        wfv1.NodeTypePod,
+					Phase:       wfv1.NodeError,
+					Message:     "timeout",
+					FinishedAt:  metav1.NewTime(finishedAt),
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, "service-resource: timeout\nstateful-set-resource: persistentvolumeclaims exceeded quota", workspaceActionFailureReason(wf))
+
+	delete(wf.Status.Nodes, "apply-statefulset")
+	delete(wf.Status.Nodes, "apply-service")
+	assert.Equal(t, "child failed", workspaceActionFailureReason(wf))
+}
@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"encoding/json"
+	"github.com/onepanelio/core/pkg/util/sql"
+	"time"
+)
+
+// WorkspaceEventType is what happened to a workspace
+type WorkspaceEventType string
+
+// Workspace event types
+const (
+	// WorkspaceEventPhaseChanged is recorded when the workspace moves to another phase, other than a failed one
+	WorkspaceEventPhaseChanged WorkspaceEventType = "PhaseChanged"
+	// WorkspaceEventFailed is recorded when the workspace moves to a failed phase, its Reason is why the action failed
+	WorkspaceEventFailed WorkspaceEventType = "Failed"
+	// WorkspaceEventParametersUpdated is recorded when the parameters are updated, its Details have the new parameters
+	WorkspaceEventParametersUpdated WorkspaceEventType = "ParametersUpdated"
+	// WorkspaceEventTemplateUpgraded is recorded when the workspace is moved to another template version, its Details have both versions
+	WorkspaceEventTemplateUpgraded WorkspaceEventType = "TemplateUpgraded"
+	// WorkspaceEventSnapshotRestored is recorded when the volumes are restored from a snapshot, its Details have the snapshot's uid
+	WorkspaceEventSnapshotRestored WorkspaceEventType = "SnapshotRestored"
+	// WorkspaceEventVolumeResized is recorded when a volume is expanded, its Details have the volume and its new size
+	WorkspaceEventVolumeResized WorkspaceEventType = "VolumeResized"
+)
+
+// WorkspaceEvent is an entry in the history of a workspace
+type WorkspaceEvent struct {
+	ID            uint64
+	WorkspaceID   uint64 `db:"workspace_id"`
+	Type          WorkspaceEventType
+	Phase         WorkspacePhase
+	PreviousPhase WorkspacePhase `db:"previous_phase"`
+	Reason        string
+	Details       map[string]interface{}
+	DetailsBytes  []byte `db:"details"` // to load from database
+	Actor         string
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+// LoadFromBytes loads Details from its database field
+func (e *WorkspaceEvent) LoadFromBytes() error {
+	return json.Unmarshal(e.DetailsBytes, &e.Details)
+}
+
+// getWorkspaceEventColumns returns all of the columns for WorkspaceEvent modified by alias, destination.
+// see formatColumnSelect
+func getWorkspaceEventColumns(aliasAndDestination ...string) []string {
+	columns := []string{"id", "workspace_id", "type", "phase", "previous_phase", "reason", "details", "actor", "created_at"}
+	return sql.FormatColumnSelect(columns, aliasAndDestination...)
+}
+
+// isWorkspaceFailedPhase returns true if the phase is one of the phases a failed action leaves the workspace in
+func isWorkspaceFailedPhase(phase WorkspacePhase) bool {
+	switch phase {
+	case WorkspaceFailedToLaunch, WorkspaceFailedToPause, WorkspaceFailedToResume, WorkspaceFailedToTerminate, WorkspaceFailedToUpdate:
+		return true
+	}
+
+	return false
+}
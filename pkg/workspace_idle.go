@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/env"
+	"github.com/onepanelio/core/pkg/util/prometheus"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+// prometheusURL is the Prometheus server that collects the Istio metrics used to detect route activity
+var prometheusURL = env.GetEnv("PROMETHEUS_URL", "http://prometheus.istio-system:9090")
+
+// RecordWorkspaceActivity records a heartbeat of the workspace, which keeps it from being paused by a heartbeat idle policy
+func (c *Client) RecordWorkspaceActivity(namespace, uid string) error {
+	result, err := sb.Update("workspaces").
+		Set("last_activity_at", time.Now().UTC()).
+		Where(sq.And{
+			sq.Eq{
+				"namespace": namespace,
+				"uid":       uid,
+			}, sq.NotEq{
+				"phase": WorkspaceTerminated,
+			},
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+
+	return nil
+}
+
+// workspaceIdleReason returns why the running workspace should be paused by the policy, or an empty string if it is active.
+// routeRequests returns the number of requests routed to the workspace during the window before now,
+// or prometheus.ErrNoData if it is unknown, then the workspace is not paused.
+func workspaceIdleReason(policy *WorkspaceIdlePolicy, status *WorkspaceStatus, now time.Time, routeRequests func(window time.Duration) (float64, error)) (string, error) {
+	timeout, err := policy.GetTimeout()
+	if err != nil {
+		return "", err
+	}
+
+	// A workspace that just launched or resumed has had no chance to be used yet
+	if status.StartedAt == nil || now.Sub(*status.StartedAt) < timeout {
+		return "", nil
+	}
+
+	switch policy.ActivitySource {
+	case WorkspaceActivityHeartbeat:
+		lastActivity := *status.StartedAt
+		if status.LastActivityAt != nil && status.LastActivityAt.After(lastActivity) {
+			lastActivity = *status.LastActivityAt
+		}
+		if now.Sub(lastActivity) < timeout {
+			return "", nil
+		}
+		return fmt.Sprintf("Paused automatically after no heartbeat for %v.", timeout), nil
+	case WorkspaceActivityRoute:
+		requests, err := routeRequests(timeout)
+		if errors.Is(err, prometheus.ErrNoData) {
+			return "", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if requests > 0 {
+			return "", nil
+		}
+		return fmt.Sprintf("Paused automatically after no requests for %v.", timeout), nil
+	}
+
+	return "", fmt.Errorf("unknown activity source %v", policy.ActivitySource)
+}
+
+// runningWorkspace is a running workspace with the manifest of its template, to read its idle policy
+type runningWorkspace struct {
+	Namespace string
+	UID       string
+	Manifest  string
+	Status    WorkspaceStatus `db:"status"`
+}
+
+// ReconcileIdleWorkspaces pauses the running workspaces that have been inactive for longer than the idle policy of their template
+func (c *Client) ReconcileIdleWorkspaces() error {
+	query := sb.Select("w.namespace", "w.uid", "wtv.manifest").
+		Columns(getWorkspaceStatusColumns("w", "status")...).
+		From("workspaces w").
+		Join("workspace_template_versions wtv ON wtv.workspace_template_id = w.workspace_template_id AND wtv.version = w.workspace_template_version").
+		Where(sq.Eq{"w.phase": WorkspaceRunning}).
+		OrderBy("w.id")
+
+	workspaces := make([]*runningWorkspace, 0)
+	if err := c.DB.Selectx(&workspaces, query); err != nil {
+		return err
+	}
+
+	prometheusClient := prometheus.NewClient(prometheusURL)
+	now := time.Now().UTC()
+	for _, w := range workspaces {
+		spec, err := parseWorkspaceSpec(w.Manifest)
+		if err != nil || spec.IdlePolicy == nil {
+			continue
+		}
+
+		routeRequests := func(window time.Duration) (float64, error) {
+			return prometheusClient.QuerySum(fmt.Sprintf(`sum(increase(istio_requests_total{destination_service_namespace="%v",destination_service_name="%v"}[%ds]))`,
+				w.Namespace, w.UID, int64(window.Seconds())))
+		}
+
+		reason, err := workspaceIdleReason(spec.IdlePolicy, &w.Status, now, routeRequests)
+		if err == nil && reason != "" {
+			err = c.updateWorkspace(w.Namespace, w.UID, "pause", "delete", &WorkspaceStatus{Phase: WorkspacePausing, Reason: reason})
+			if err == nil {
+				log.WithFields(log.Fields{
+					"Namespace": w.Namespace,
+					"UID":       w.UID,
+					"Reason":    reason,
+				}).Info("Paused idle workspace.")
+			}
+		}
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": w.Namespace,
+				"UID":       w.UID,
+				"Error":     err.Error(),
+			}).Error("Unable to reconcile idle workspace.")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"errors"
+	"github.com/onepanelio/core/pkg/util/prometheus"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestWorkspaceIdlePolicy_Validate(t *testing.T) {
+	assert.Nil(t, (&WorkspaceIdlePolicy{Timeout: "2h", ActivitySource: WorkspaceActivityRoute}).Validate())
+	assert.NotNil(t, (&WorkspaceIdlePolicy{Timeout: "2 hours", ActivitySource: WorkspaceActivityRoute}).Validate())
+	assert.NotNil(t, (&WorkspaceIdlePolicy{Timeout: "-1h", ActivitySource: WorkspaceActivityHeartbeat}).Validate())
+	assert.NotNil(t, (&WorkspaceIdlePolicy{Timeout: "2h", ActivitySource: "cpu"}).Validate())
+}
+
+// Test_workspaceIdleReason_Heartbeat makes sure a workspace is idle once neither its start nor its last heartbeat are within the timeout
+func Test_workspaceIdleReason_Heartbeat(t *testing.T) {
+	now := time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)
+	policy := &WorkspaceIdlePolicy{Timeout: "1h", ActivitySource: WorkspaceActivityHeartbeat}
+	noRoute := func(window time.Duration) (float64, error) {
+		return 0, errors.New("route activity should not be queried")
+	}
+
+	startedAt := now.Add(-30 * time.Minute)
+	reason, err := workspaceIdleReason(policy, &WorkspaceStatus{StartedAt: &startedAt}, now, noRoute)
+	assert.Nil(t, err)
+	assert.Empty(t, reason)
+
+	startedAt = now.Add(-2 * time.Hour)
+	lastActivityAt := now.Add(-10 * time.Minute)
+	reason, err = workspaceIdleReason(policy, &WorkspaceStatus{StartedAt: &startedAt, LastActivityAt: &lastActivityAt}, now, noRoute)
+	assert.Nil(t, err)
+	assert.Empty(t, reason)
+
+	lastActivityAt = now.Add(-90 * time.Minute)
+	reason, err = workspaceIdleReason(policy, &WorkspaceStatus{StartedAt: &startedAt, LastActivityAt: &lastActivityAt}, now, noRoute)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, reason)
+}
+
+// Test_workspaceIdleReason_Route makes sure a workspace is idle if no requests were routed to it during the timeout
+func Test_workspaceIdleReason_Route(t *testing.T) {
+	now := time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)
+	startedAt := now.Add(-2 * time.Hour)
+	policy := &WorkspaceIdlePolicy{Timeout: "1h", ActivitySource: WorkspaceActivityRoute}
+
+	requests := float64(3)
+	routeRequests := func(window time.Duration) (float64, error) {
+		assert.Equal(t, time.Hour, window)
+		return requests, nil
+	}
+
+	reason, err := workspaceIdleReason(policy, &WorkspaceStatus{StartedAt: &startedAt}, now, routeRequests)
+	assert.Nil(t, err)
+	assert.Empty(t, reason)
+
+	requests = 0
+	reason, err = workspaceIdleReason(policy, &WorkspaceStatus{StartedAt: &startedAt}, now, routeRequests)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, reason)
+
+	// Without Istio metrics for the workspace its activity is unknown
+	reason, err = workspaceIdleReason(policy, &WorkspaceStatus{StartedAt: &startedAt}, now, func(window time.Duration) (float64, error) {
+		return 0, prometheus.ErrNoData
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, reason)
+}
@@ -0,0 +1,242 @@
+package v1
+
+import (
+	"fmt"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sort"
+	"time"
+)
+
+// workspacePodLabelKey is the label the workspace's StatefulSet selects its pods with, its value is the workspace's uid
+const workspacePodLabelKey = "app"
+
+// listWorkspacePods returns the pods of the workspace's StatefulSet sorted by name
+func (c *Client) listWorkspacePods(namespace, uid string) ([]corev1.Pod, error) {
+	podList, err := c.CoreV1().Pods(namespace).List(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", workspacePodLabelKey, uid),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pods := podList.Items
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].Name < pods[j].Name
+	})
+
+	return pods, nil
+}
+
+// getLastWorkspaceActionWorkflow returns the most recent workflow that created, updated, paused or deleted the workspace.
+// Action workflows are created from the workspace template's workflow template, with the workspace's uid as the sys-uid parameter.
+func (c *Client) getLastWorkspaceActionWorkflow(namespace string, workspace *Workspace) (*wfv1.Workflow, error) {
+	workflowTemplate, err := c.getWorkflowTemplateById(workspace.WorkspaceTemplate.WorkflowTemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowList, err := c.ArgoprojV1alpha1().Workflows(namespace).List(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", workflowTemplateUIDLabelKey, workflowTemplate.UID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var lastWorkflow *wfv1.Workflow
+	for i := range workflowList.Items {
+		wf := &workflowList.Items[i]
+
+		isAction := false
+		for _, p := range wf.Spec.Arguments.Parameters {
+			if p.Name == "sys-uid" && p.Value != nil && *p.Value == workspace.UID {
+				isAction = true
+				break
+			}
+		}
+		if !isAction {
+			continue
+		}
+
+		if lastWorkflow == nil || wf.CreationTimestamp.After(lastWorkflow.CreationTimestamp.Time) {
+			lastWorkflow = wf
+		}
+	}
+
+	return lastWorkflow, nil
+}
+
+// GetWorkspaceLogs calls fn with the entries of a container's log in one of the workspace's pods, or the logs of its last action workflow.
+// If options.Follow is set, it returns once the log ends or done is closed.
+func (c *Client) GetWorkspaceLogs(namespace, uid string, options *WorkspaceLogOptions, done <-chan struct{}, fn func(entry *LogEntry) error) error {
+	workspace, err := c.GetWorkspace(namespace, uid)
+	if err != nil {
+		return util.NewUserError(codes.Unknown, err.Error())
+	}
+	if workspace == nil {
+		return util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+
+	if options.Action {
+		return c.getWorkspaceActionLogs(namespace, workspace, options, done, fn)
+	}
+
+	pods, err := c.listWorkspacePods(namespace, uid)
+	if err != nil {
+		return err
+	}
+	if len(pods) == 0 {
+		return util.NewUserError(codes.NotFound, "Workspace is not running.")
+	}
+
+	pod := &pods[0]
+	if options.PodName != "" {
+		pod = nil
+		for i := range pods {
+			if pods[i].Name == options.PodName {
+				pod = &pods[i]
+				break
+			}
+		}
+		if pod == nil {
+			return util.NewUserError(codes.NotFound, "Pod not found.")
+		}
+	}
+
+	containerName := options.ContainerName
+	if containerName == "" && len(pod.Spec.Containers) > 0 {
+		containerName = pod.Spec.Containers[0].Name
+	}
+
+	logOptions := &corev1.PodLogOptions{
+		Container:  containerName,
+		Follow:     options.Follow,
+		Previous:   options.Previous,
+		Timestamps: true,
+	}
+	if options.TailLines > 0 {
+		logOptions.TailLines = &options.TailLines
+	}
+
+	stream, err := c.CoreV1().Pods(namespace).GetLogs(pod.Name, logOptions).Stream()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace":     namespace,
+			"UID":           uid,
+			"PodName":       pod.Name,
+			"ContainerName": containerName,
+			"Error":         err.Error(),
+		}).Error("Error with logs.")
+		return util.NewUserError(codes.NotFound, "Log not found.")
+	}
+	defer stream.Close()
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-done:
+			stream.Close()
+		case <-stop:
+		}
+	}()
+
+	err = filterLogLines(stream, nil, func(line string, entry *LogEntry) error {
+		entry.PodName = pod.Name
+		entry.ContainerName = containerName
+
+		return fn(entry)
+	})
+	select {
+	case <-done:
+		return nil
+	default:
+		return err
+	}
+}
+
+// getWorkspaceActionLogs calls fn with the entries of the logs of the workspace's last action workflow, merged in timestamp order.
+// If options.Follow is set, the logs are followed until the workflow finishes and options.TailLines is ignored.
+// Otherwise the last options.TailLines entries of the merged logs are among the last options.TailLines entries of each log,
+// so only those are kept.
+func (c *Client) getWorkspaceActionLogs(namespace string, workspace *Workspace, options *WorkspaceLogOptions, done <-chan struct{}, fn func(entry *LogEntry) error) error {
+	wf, err := c.getLastWorkspaceActionWorkflow(namespace, workspace)
+	if err != nil {
+		return err
+	}
+	if wf == nil {
+		return util.NewUserError(codes.NotFound, "Workspace action not found.")
+	}
+
+	containerName := options.ContainerName
+	if containerName == "" {
+		containerName = "main"
+	}
+
+	if options.Follow {
+		return c.AggregateWorkflowExecutionLogs(namespace, wf.Name, nil, containerName, done, fn)
+	}
+
+	aggregator := newLogAggregator(0)
+	for _, node := range wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypePod || node.StartedAt.IsZero() {
+			continue
+		}
+		aggregator.addSource(node.ID, false)
+		if err := c.readWorkspaceActionLog(namespace, wf.Name, node, containerName, options.TailLines, aggregator); err != nil {
+			return err
+		}
+	}
+
+	entries := aggregator.flush(time.Now(), true)
+	if options.TailLines > 0 && int64(len(entries)) > options.TailLines {
+		entries = entries[int64(len(entries))-options.TailLines:]
+	}
+	for _, entry := range entries {
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readWorkspaceActionLog adds the entries of the node's log to aggregator, only the last tailLines if it is set.
+// The log is read line by line, so only those entries are held in memory.
+// Steps that did not create the container, or whose log was not archived, are skipped.
+func (c *Client) readWorkspaceActionLog(namespace, workflowName string, node wfv1.NodeStatus, containerName string, tailLines int64, aggregator *logAggregator) error {
+	stream, err := c.openWorkflowExecutionLog(namespace, workflowName, node.ID, containerName, false)
+	if err != nil {
+		return nil
+	}
+	defer stream.Close()
+
+	if tailLines <= 0 {
+		return filterLogLines(stream, nil, func(line string, entry *LogEntry) error {
+			setNodeLogEntryFields(entry, node, containerName)
+			aggregator.add(node.ID, entry)
+
+			return nil
+		})
+	}
+
+	ring := newLogRing(int(tailLines))
+	err = filterLogLines(stream, nil, func(line string, entry *LogEntry) error {
+		ring.add(entry)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, entry := range ring.entries() {
+		setNodeLogEntryFields(entry, node, containerName)
+		aggregator.add(node.ID, entry)
+	}
+
+	return nil
+}
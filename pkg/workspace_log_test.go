@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+	"testing"
+)
+
+// Test_listWorkspacePods makes sure only the pods of the workspace are returned, sorted by name
+func Test_listWorkspacePods(t *testing.T) {
+	c := &Client{
+		Interface: fake.NewSimpleClientset(
+			newTestPod("jupyterlab-1", "jupyterlab"),
+			newTestPod("jupyterlab-0", "jupyterlab"),
+			newTestPod("vscode-0", "vscode"),
+		),
+	}
+
+	pods, err := c.listWorkspacePods("onepanel", "jupyterlab")
+	assert.Nil(t, err)
+	if assert.Len(t, pods, 2) {
+		assert.Equal(t, "jupyterlab-0", pods[0].Name)
+		assert.Equal(t, "jupyterlab-1", pods[1].Name)
+	}
+
+	pods, err = c.listWorkspacePods("onepanel", "cvat")
+	assert.Nil(t, err)
+	assert.Empty(t, pods)
+}
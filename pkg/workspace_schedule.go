@@ -0,0 +1,137 @@
+package v1
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+// setWorkspaceSchedule sets the schedule of the workspace, or removes it if schedule is nil.
+// Times scheduled before now are not applied.
+func (c *Client) setWorkspaceSchedule(namespace, uid string, schedule *WorkspaceSchedule) error {
+	result, err := sb.Update("workspaces").
+		SetMap(sq.Eq{
+			"schedule":            schedule,
+			"schedule_checked_at": time.Now().UTC(),
+			"modified_at":         time.Now().UTC(),
+		}).
+		Where(sq.And{
+			sq.Eq{
+				"namespace": namespace,
+				"uid":       uid,
+			}, sq.NotEq{
+				"phase": WorkspaceTerminated,
+			},
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+
+	return nil
+}
+
+// UpdateWorkspaceSchedule creates or replaces the schedule that resumes and pauses the workspace
+func (c *Client) UpdateWorkspaceSchedule(namespace, uid string, schedule *WorkspaceSchedule) error {
+	if schedule == nil {
+		return util.NewUserError(codes.InvalidArgument, "Schedule is required.")
+	}
+	if err := schedule.Validate(); err != nil {
+		return util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	return c.setWorkspaceSchedule(namespace, uid, schedule)
+}
+
+// DeleteWorkspaceSchedule removes the schedule of the workspace
+func (c *Client) DeleteWorkspaceSchedule(namespace, uid string) error {
+	return c.setWorkspaceSchedule(namespace, uid, nil)
+}
+
+// scheduledWorkspace is a workspace with a schedule and the time up to which it has been applied
+type scheduledWorkspace struct {
+	ID                uint64
+	Namespace         string
+	UID               string
+	Phase             WorkspacePhase
+	Schedule          *WorkspaceSchedule
+	ScheduleCheckedAt *time.Time `db:"schedule_checked_at"`
+}
+
+// ReconcileWorkspaceSchedules resumes and pauses the workspaces whose schedule had a time since the last reconcile.
+// If several times passed, only the last one is applied. A workspace that is not paused is not resumed, and one that is not running is not paused.
+// If applying the action fails, it is retried on the next reconcile.
+func (c *Client) ReconcileWorkspaceSchedules() error {
+	query := sb.Select("w.id", "w.namespace", "w.uid", "w.phase", "w.schedule", "w.schedule_checked_at").
+		From("workspaces w").
+		Where(sq.NotEq{
+			"w.schedule": nil,
+			"w.phase":    WorkspaceTerminated,
+		}).
+		OrderBy("w.id")
+
+	workspaces := make([]*scheduledWorkspace, 0)
+	if err := c.DB.Selectx(&workspaces, query); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for _, w := range workspaces {
+		checkedAt := now
+		if w.ScheduleCheckedAt != nil {
+			checkedAt = *w.ScheduleCheckedAt
+		}
+
+		action, ok, err := w.Schedule.lastAction(checkedAt, now)
+		if err == nil && ok {
+			switch {
+			case action == WorkspaceScheduleResume && w.Phase == WorkspacePaused:
+				err = c.resumeWorkspace(w.Namespace, w.UID, "Resumed by schedule.")
+			case action == WorkspaceSchedulePause && w.Phase == WorkspaceRunning:
+				err = c.updateWorkspace(w.Namespace, w.UID, "pause", "delete", &WorkspaceStatus{Phase: WorkspacePausing, Reason: "Paused by schedule."})
+			}
+		}
+		// A resume that exceeds the quota is skipped, so the workspace is not resumed at an unscheduled time once there is room
+		if userError, ok := err.(*util.UserError); ok && userError.Code == codes.ResourceExhausted {
+			log.WithFields(log.Fields{
+				"Namespace": w.Namespace,
+				"UID":       w.UID,
+				"Action":    action,
+				"Error":     err.Error(),
+			}).Warn("Skipped workspace schedule action.")
+			err = nil
+		}
+		// Otherwise the schedule is checked again on the next reconcile, so the action is retried
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": w.Namespace,
+				"UID":       w.UID,
+				"Action":    action,
+				"Error":     err.Error(),
+			}).Error("Unable to apply workspace schedule.")
+			continue
+		}
+
+		_, err = sb.Update("workspaces").
+			Set("schedule_checked_at", now).
+			Where(sq.Eq{"id": w.ID}).
+			RunWith(c.DB).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package v1
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/robfig/cron"
+	"time"
+)
+
+// WorkspaceScheduleAction is what a workspace schedule does at a scheduled time
+type WorkspaceScheduleAction string
+
+// Workspace schedule actions
+const (
+	WorkspaceScheduleResume WorkspaceScheduleAction = "resume"
+	WorkspaceSchedulePause  WorkspaceScheduleAction = "pause"
+)
+
+// workspaceScheduleMaxCatchUp is how far back missed scheduled times are looked for, for example after the server was down
+const workspaceScheduleMaxCatchUp = 24 * time.Hour
+
+// WorkspaceSchedule resumes and pauses a workspace at the times of standard cron expressions, such as "0 8 * * 1-5".
+// Either expression may be empty. The expressions are evaluated in Timezone, an IANA name such as America/New_York, or UTC if empty.
+type WorkspaceSchedule struct {
+	Resume   string `json:"resume,omitempty"`
+	Pause    string `json:"pause,omitempty"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// Validate returns an error if an expression or the timezone are invalid
+func (s *WorkspaceSchedule) Validate() error {
+	if s.Resume == "" && s.Pause == "" {
+		return errors.New("schedule needs a resume or pause expression")
+	}
+	if _, err := s.location(); err != nil {
+		return err
+	}
+	for _, action := range []WorkspaceScheduleAction{WorkspaceScheduleResume, WorkspaceSchedulePause} {
+		schedule, err := s.cronSchedule(action)
+		if err != nil {
+			return err
+		}
+		// Expressions such as "0 0 30 2 *" parse, but never match
+		if schedule != nil && schedule.Next(time.Now()).IsZero() {
+			return fmt.Errorf("%v expression never matches", action)
+		}
+	}
+
+	return nil
+}
+
+// location returns the timezone of the schedule
+func (s *WorkspaceSchedule) location() (*time.Location, error) {
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %v", s.Timezone)
+	}
+
+	return location, nil
+}
+
+// cronSchedule parses the expression of the action, it returns nil if the expression is empty
+func (s *WorkspaceSchedule) cronSchedule(action WorkspaceScheduleAction) (cron.Schedule, error) {
+	expression := s.Resume
+	if action == WorkspaceSchedulePause {
+		expression = s.Pause
+	}
+	if expression == "" {
+		return nil, nil
+	}
+
+	schedule, err := cron.ParseStandard(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %v expression: %v", action, err)
+	}
+
+	return schedule, nil
+}
+
+// Next returns the next time after t the action is scheduled, or nil if it has no expression or it never matches
+func (s *WorkspaceSchedule) Next(action WorkspaceScheduleAction, t time.Time) (*time.Time, error) {
+	location, err := s.location()
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := s.cronSchedule(action)
+	if err != nil || schedule == nil {
+		return nil, err
+	}
+
+	next := schedule.Next(t.In(location))
+	if next.IsZero() {
+		return nil, nil
+	}
+	next = next.UTC()
+
+	return &next, nil
+}
+
+// lastAction returns the action scheduled last in (from, to], if any.
+// If both are scheduled, the later one wins. If they are scheduled at the same time, the workspace is paused.
+func (s *WorkspaceSchedule) lastAction(from, to time.Time) (action WorkspaceScheduleAction, ok bool, err error) {
+	if to.Sub(from) > workspaceScheduleMaxCatchUp {
+		from = to.Add(-workspaceScheduleMaxCatchUp)
+	}
+
+	var last time.Time
+	for _, a := range []WorkspaceScheduleAction{WorkspaceScheduleResume, WorkspaceSchedulePause} {
+		next, err := s.Next(a, from)
+		if err != nil {
+			return "", false, err
+		}
+		// next is nil once the expression no longer matches, so an impossible date such as Feb 30 ends the loop
+		var latest *time.Time
+		for next != nil && !next.After(to) {
+			latest = next
+			if next, err = s.Next(a, *next); err != nil {
+				return "", false, err
+			}
+		}
+		if latest != nil && !latest.Before(last) {
+			last = *latest
+			action = a
+			ok = true
+		}
+	}
+
+	return
+}
+
+// Value stores the schedule as JSON
+func (s WorkspaceSchedule) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+// Scan loads the schedule from JSON
+func (s *WorkspaceSchedule) Scan(src interface{}) error {
+	switch t := src.(type) {
+	case string:
+		return json.Unmarshal([]byte(t), s)
+	case []byte:
+		return json.Unmarshal(t, s)
+	}
+
+	return errors.New("incompatible type for WorkspaceSchedule")
+}
@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestWorkspaceSchedule_Validate(t *testing.T) {
+	assert.Nil(t, (&WorkspaceSchedule{Resume: "0 8 * * 1-5", Pause: "0 20 * * 1-5", Timezone: "America/New_York"}).Validate())
+	assert.Nil(t, (&WorkspaceSchedule{Pause: "0 20 * * *"}).Validate())
+	assert.NotNil(t, (&WorkspaceSchedule{}).Validate())
+	assert.NotNil(t, (&WorkspaceSchedule{Resume: "every morning"}).Validate())
+	assert.NotNil(t, (&WorkspaceSchedule{Resume: "0 8 * * *", Timezone: "Mars/Olympus_Mons"}).Validate())
+}
+
+// TestWorkspaceSchedule_Next makes sure expressions are evaluated in the schedule's timezone
+func TestWorkspaceSchedule_Next(t *testing.T) {
+	schedule := &WorkspaceSchedule{Resume: "0 8 * * 1-5", Timezone: "America/New_York"}
+
+	// Friday 2020-05-01 13:00 UTC is 09:00 in New York, so the next resume is on Monday
+	next, err := schedule.Next(WorkspaceScheduleResume, time.Date(2020, 5, 1, 13, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2020, 5, 4, 12, 0, 0, 0, time.UTC), *next)
+
+	next, err = schedule.Next(WorkspaceSchedulePause, time.Now())
+	assert.Nil(t, err)
+	assert.Nil(t, next)
+}
+
+// TestWorkspaceSchedule_lastAction makes sure the action scheduled last in the range is returned
+func TestWorkspaceSchedule_lastAction(t *testing.T) {
+	schedule := &WorkspaceSchedule{Resume: "0 8 * * 1-5", Pause: "0 20 * * 1-5"}
+	monday := time.Date(2020, 5, 4, 0, 0, 0, 0, time.UTC)
+
+	_, ok, err := schedule.lastAction(monday, monday.Add(7*time.Hour))
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	action, ok, err := schedule.lastAction(monday.Add(7*time.Hour), monday.Add(8*time.Hour))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, WorkspaceScheduleResume, action)
+
+	action, ok, err = schedule.lastAction(monday, monday.Add(21*time.Hour))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, WorkspaceSchedulePause, action)
+}
+
+// TestWorkspaceSchedule_NeverMatches makes sure an expression that parses but never matches, such as Feb 30, is rejected
+// and does not keep lastAction looking for the next time forever
+func TestWorkspaceSchedule_NeverMatches(t *testing.T) {
+	schedule := &WorkspaceSchedule{Resume: "0 0 30 2 *", Pause: "0 20 * * 1-5"}
+	assert.NotNil(t, schedule.Validate())
+
+	next, err := schedule.Next(WorkspaceScheduleResume, time.Now())
+	assert.Nil(t, err)
+	assert.Nil(t, next)
+
+	monday := time.Date(2020, 5, 4, 0, 0, 0, 0, time.UTC)
+	action, ok, err := schedule.lastAction(monday, monday.Add(21*time.Hour))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, WorkspaceSchedulePause, action)
+}
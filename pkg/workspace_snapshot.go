@@ -0,0 +1,526 @@
+package v1
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/pagination"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
+	"time"
+)
+
+const (
+	// workspaceLabelKey labels the VolumeSnapshots of a workspace snapshot with the workspace's uid
+	workspaceLabelKey = "onepanel.io/workspace"
+	// workspaceSnapshotLabelKey labels the VolumeSnapshots of a workspace snapshot with the snapshot's uid
+	workspaceSnapshotLabelKey = "onepanel.io/workspace-snapshot"
+)
+
+// workspaceVolumeDeleteTimeout is how long restoring a snapshot waits for the workspace's old claims to be deleted
+var workspaceVolumeDeleteTimeout = time.Minute
+
+// workspaceSnapshotsSelectBuilder selects the snapshots of the most recent workspace with the uid
+func workspaceSnapshotsSelectBuilder(namespace, workspaceUID string) sq.SelectBuilder {
+	return sb.Select(getWorkspaceSnapshotColumns("ws")...).
+		Columns(`w.uid "workspace_uid"`).
+		From("workspace_snapshots ws").
+		Join("workspaces w ON w.id = ws.workspace_id").
+		Where(latestWorkspaceOf(namespace, workspaceUID))
+}
+
+// loadWorkspaceSnapshotStatus sets Ready and Error of the workspace's snapshots from their VolumeSnapshots
+func (c *Client) loadWorkspaceSnapshotStatus(namespace, workspaceUID string, snapshots ...*WorkspaceSnapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+
+	volumeSnapshots, err := c.listVolumeSnapshots(namespace, fmt.Sprintf("%s=%s", workspaceLabelKey, workspaceUID))
+	if err != nil {
+		return err
+	}
+
+	for _, snapshot := range snapshots {
+		snapshot.Ready = len(snapshot.VolumeSnapshots) > 0
+		snapshot.Error = ""
+		for volumeName, name := range snapshot.VolumeSnapshots {
+			volumeSnapshot, ok := volumeSnapshots[name]
+			if !ok {
+				snapshot.Ready = false
+				snapshot.Error = fmt.Sprintf("Snapshot of volume %v not found.", volumeName)
+				break
+			}
+
+			ready, message := volumeSnapshotStatus(volumeSnapshot)
+			snapshot.Ready = snapshot.Ready && ready
+			if message != "" {
+				snapshot.Error = message
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateWorkspaceSnapshot creates a VolumeSnapshot of every volume of the workspace and records them as the snapshot.
+// Every volume's storage class must support snapshots.
+func (c *Client) CreateWorkspaceSnapshot(namespace, workspaceUID string, snapshot *WorkspaceSnapshot) (*WorkspaceSnapshot, error) {
+	workspace, err := c.GetWorkspace(namespace, workspaceUID)
+	if err != nil {
+		return nil, util.NewUserError(codes.Unknown, err.Error())
+	}
+	if workspace == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+	if workspace.Status.Phase != WorkspaceRunning && workspace.Status.Phase != WorkspacePaused {
+		return nil, util.NewUserError(codes.FailedPrecondition, "Only running or paused workspaces can be snapshotted.")
+	}
+
+	if err := snapshot.GenerateUID(snapshot.Name); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+	existingSnapshot, err := c.GetWorkspaceSnapshot(namespace, workspaceUID, snapshot.UID)
+	if err != nil {
+		return nil, err
+	}
+	if existingSnapshot != nil {
+		return nil, util.NewUserError(codes.AlreadyExists, "Snapshot already exists.")
+	}
+
+	claims, err := c.listWorkspaceVolumeClaims(namespace, workspaceUID)
+	if err != nil {
+		return nil, err
+	}
+	if len(claims) == 0 {
+		return nil, util.NewUserError(codes.FailedPrecondition, "Workspace has no volumes.")
+	}
+
+	classNames := make(map[string]string)
+	for volumeName, claim := range claims {
+		className, err := c.getVolumeSnapshotClassName(claim.Spec.StorageClassName)
+		if err != nil {
+			return nil, err
+		}
+		if className == "" {
+			return nil, util.NewUserError(codes.FailedPrecondition, fmt.Sprintf("The storage class of volume %v does not support snapshots.", volumeName))
+		}
+		classNames[volumeName] = className
+	}
+
+	snapshot.Namespace = namespace
+	snapshot.WorkspaceID = workspace.ID
+	snapshot.WorkspaceUID = workspaceUID
+	snapshot.VolumeSnapshots = make(map[string]string)
+	for volumeName, claim := range claims {
+		name := fmt.Sprintf("%v-%v", claim.Name, snapshot.UID)
+		err := c.createVolumeSnapshot(namespace, name, classNames[volumeName], claim.Name, map[string]string{
+			workspaceLabelKey:         workspaceUID,
+			workspaceSnapshotLabelKey: snapshot.UID,
+		})
+		if err != nil {
+			c.deleteVolumeSnapshots(namespace, snapshot.VolumeSnapshots)
+			return nil, err
+		}
+		snapshot.VolumeSnapshots[volumeName] = name
+	}
+
+	volumeSnapshots, err := json.Marshal(snapshot.VolumeSnapshots)
+	if err != nil {
+		c.deleteVolumeSnapshots(namespace, snapshot.VolumeSnapshots)
+		return nil, err
+	}
+
+	err = sb.Insert("workspace_snapshots").
+		SetMap(sq.Eq{
+			"uid":              snapshot.UID,
+			"name":             snapshot.Name,
+			"namespace":        namespace,
+			"workspace_id":     workspace.ID,
+			"volume_snapshots": volumeSnapshots,
+			"labels":           snapshot.Labels,
+		}).
+		Suffix("RETURNING id, created_at").
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&snapshot.ID, &snapshot.CreatedAt)
+	if err != nil {
+		c.deleteVolumeSnapshots(namespace, snapshot.VolumeSnapshots)
+		return nil, util.NewUserError(codes.Unknown, err.Error())
+	}
+
+	return snapshot, nil
+}
+
+// GetWorkspaceSnapshot returns the snapshot of the workspace with the uid, or nil if there is none
+func (c *Client) GetWorkspaceSnapshot(namespace, workspaceUID, uid string) (*WorkspaceSnapshot, error) {
+	query := workspaceSnapshotsSelectBuilder(namespace, workspaceUID).
+		Where(sq.Eq{"ws.uid": uid})
+
+	snapshot := &WorkspaceSnapshot{}
+	if err := c.DB.Getx(snapshot, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := snapshot.LoadFromBytes(); err != nil {
+		return nil, err
+	}
+	if err := c.loadWorkspaceSnapshotStatus(namespace, workspaceUID, snapshot); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// ListWorkspaceSnapshots returns the snapshots of the workspace matching the labels, most recent first
+func (c *Client) ListWorkspaceSnapshots(namespace, workspaceUID string, paginator *pagination.PaginationRequest, labels []*Label) (snapshots []*WorkspaceSnapshot, err error) {
+	query := workspaceSnapshotsSelectBuilder(namespace, workspaceUID).
+		OrderBy("ws.created_at DESC", "ws.id DESC")
+	query = *paginator.ApplyToSelect(&query, "ws.created_at", "ws.id")
+	if len(labels) > 0 {
+		labelsJSON, err := LabelsToJSONString(labels)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where("ws.labels @> ?", labelsJSON)
+	}
+
+	snapshots = make([]*WorkspaceSnapshot, 0)
+	if err = c.DB.Selectx(&snapshots, query); err != nil {
+		return nil, err
+	}
+
+	for _, snapshot := range snapshots {
+		if err := snapshot.LoadFromBytes(); err != nil {
+			return nil, err
+		}
+	}
+
+	err = c.loadWorkspaceSnapshotStatus(namespace, workspaceUID, snapshots...)
+
+	return
+}
+
+// CountWorkspaceSnapshots returns the number of snapshots of the workspace matching the labels
+func (c *Client) CountWorkspaceSnapshots(namespace, workspaceUID string, labels []*Label) (count int, err error) {
+	query := sb.Select("COUNT(*)").
+		From("workspace_snapshots ws").
+		Join("workspaces w ON w.id = ws.workspace_id").
+		Where(latestWorkspaceOf(namespace, workspaceUID))
+	if len(labels) > 0 {
+		labelsJSON, err := LabelsToJSONString(labels)
+		if err != nil {
+			return 0, err
+		}
+		query = query.Where("ws.labels @> ?", labelsJSON)
+	}
+
+	err = query.RunWith(c.DB).
+		QueryRow().
+		Scan(&count)
+
+	return
+}
+
+// deleteVolumeSnapshots deletes the VolumeSnapshots with the names, errors are only logged
+func (c *Client) deleteVolumeSnapshots(namespace string, names map[string]string) {
+	for _, name := range names {
+		err := c.Dynamic().Resource(volumeSnapshotResource).Namespace(namespace).Delete(name, &metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Name":      name,
+				"Error":     err.Error(),
+			}).Error("Unable to delete volume snapshot.")
+		}
+	}
+}
+
+// DeleteWorkspaceSnapshot deletes the snapshot and its VolumeSnapshots
+func (c *Client) DeleteWorkspaceSnapshot(namespace, workspaceUID, uid string) error {
+	snapshot, err := c.GetWorkspaceSnapshot(namespace, workspaceUID, uid)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return util.NewUserError(codes.NotFound, "Snapshot not found.")
+	}
+
+	c.deleteVolumeSnapshots(namespace, snapshot.VolumeSnapshots)
+
+	_, err = sb.Delete("workspace_snapshots").
+		Where(sq.Eq{"id": snapshot.ID}).
+		RunWith(c.DB).
+		Exec()
+
+	return err
+}
+
+// RestoreWorkspaceSnapshot replaces the volumes of the paused workspace with volumes restored from the snapshot.
+// The data written to the volumes since the snapshot is lost. The workspace uses the restored volumes once it is resumed.
+func (c *Client) RestoreWorkspaceSnapshot(namespace, workspaceUID, uid string) error {
+	workspace, err := c.GetWorkspace(namespace, workspaceUID)
+	if err != nil {
+		return util.NewUserError(codes.Unknown, err.Error())
+	}
+	if workspace == nil {
+		return util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+	if workspace.Status.Phase != WorkspacePaused {
+		return util.NewUserError(codes.FailedPrecondition, "Workspace must be paused to restore a snapshot.")
+	}
+
+	snapshot, err := c.GetWorkspaceSnapshot(namespace, workspaceUID, uid)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return util.NewUserError(codes.NotFound, "Snapshot not found.")
+	}
+	if !snapshot.Ready {
+		return util.NewUserError(codes.FailedPrecondition, "Snapshot is not ready to be restored.")
+	}
+
+	// The workspace is Restoring while its volumes are replaced, so it can not be resumed or restored again meanwhile
+	moved, err := c.moveWorkspacePhase(namespace, workspaceUID, WorkspacePaused, WorkspaceRestoring)
+	if err != nil {
+		return err
+	}
+	if !moved {
+		return util.NewUserError(codes.FailedPrecondition, "Workspace must be paused to restore a snapshot.")
+	}
+	c.createWorkspacePhaseEvent(workspace, WorkspaceRestoring, "")
+	defer func() {
+		if _, err := c.moveWorkspacePhase(namespace, workspaceUID, WorkspaceRestoring, WorkspacePaused); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"UID":       workspaceUID,
+				"Error":     err.Error(),
+			}).Error("Unable to pause workspace after restoring snapshot.")
+			return
+		}
+		c.createWorkspacePhaseEvent(&Workspace{ID: workspace.ID, Status: WorkspaceStatus{Phase: WorkspaceRestoring}}, WorkspacePaused, "")
+	}()
+
+	claims, err := c.listWorkspaceVolumeClaims(namespace, workspaceUID)
+	if err != nil {
+		return err
+	}
+	for volumeName := range snapshot.VolumeSnapshots {
+		if _, ok := claims[volumeName]; !ok {
+			return util.NewUserError(codes.FailedPrecondition, fmt.Sprintf("Volume %v of the snapshot not found.", volumeName))
+		}
+	}
+
+	if err := c.restoreWorkspaceVolumes(namespace, workspaceUID, claims, snapshot.VolumeSnapshots); err != nil {
+		return err
+	}
+
+	c.createWorkspaceEvent(&WorkspaceEvent{
+		WorkspaceID: workspace.ID,
+		Type:        WorkspaceEventSnapshotRestored,
+		Phase:       workspace.Status.Phase,
+		Details: map[string]interface{}{
+			"snapshot": snapshot.UID,
+		},
+	})
+
+	return nil
+}
+
+// moveWorkspacePhase sets the phase of the workspace to phase, if it is in phase from. moved is false otherwise.
+func (c *Client) moveWorkspacePhase(namespace, uid string, from, phase WorkspacePhase) (moved bool, err error) {
+	result, err := updateWorkspaceStatusBuilder(namespace, uid, &WorkspaceStatus{Phase: phase}).
+		Where(sq.Eq{"phase": from}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// restoreWorkspaceVolumes replaces the workspace's claims with claims restored from the VolumeSnapshots, by volume name.
+// The old volumes are retained until every restored claim is created. If one can not be created,
+// the restored claims are deleted and the old claims are bound to the old volumes again.
+func (c *Client) restoreWorkspaceVolumes(namespace, workspaceUID string, claims map[string]corev1.PersistentVolumeClaim, volumeSnapshots map[string]string) error {
+	reclaimPolicies := make(map[string]corev1.PersistentVolumeReclaimPolicy)
+	var oldClaimNames []string
+	for volumeName := range volumeSnapshots {
+		oldClaim := claims[volumeName]
+		if oldClaim.Spec.VolumeName == "" {
+			c.setVolumeReclaimPolicies(reclaimPolicies)
+			return util.NewUserError(codes.FailedPrecondition, fmt.Sprintf("Volume %v is not bound.", volumeName))
+		}
+
+		policy, err := c.retainVolume(oldClaim.Spec.VolumeName)
+		if err != nil {
+			c.setVolumeReclaimPolicies(reclaimPolicies)
+			return err
+		}
+		reclaimPolicies[oldClaim.Spec.VolumeName] = policy
+		oldClaimNames = append(oldClaimNames, oldClaim.Name)
+	}
+
+	if err := c.deleteWorkspaceVolumeClaims(namespace, oldClaimNames); err != nil {
+		c.rollbackWorkspaceVolumes(namespace, workspaceUID, claims, volumeSnapshots, reclaimPolicies)
+		return err
+	}
+
+	for volumeName, name := range volumeSnapshots {
+		oldClaim := claims[volumeName]
+		claim := newWorkspaceVolumeClaim(&oldClaim, volumeName, workspaceUID)
+		claim.Spec.DataSource = volumeSnapshotDataSource(name)
+
+		if _, err := c.CoreV1().PersistentVolumeClaims(namespace).Create(claim); err != nil {
+			c.rollbackWorkspaceVolumes(namespace, workspaceUID, claims, volumeSnapshots, reclaimPolicies)
+			return err
+		}
+	}
+
+	// The old volumes are released, their original reclaim policy deletes them
+	c.setVolumeReclaimPolicies(reclaimPolicies)
+
+	return nil
+}
+
+// rollbackWorkspaceVolumes deletes the claims restored by restoreWorkspaceVolumes and binds the old claims to the retained volumes again.
+// Volumes that can not be bound again keep the Retain policy, so no data is lost.
+func (c *Client) rollbackWorkspaceVolumes(namespace, workspaceUID string, claims map[string]corev1.PersistentVolumeClaim, volumeSnapshots map[string]string, reclaimPolicies map[string]corev1.PersistentVolumeReclaimPolicy) {
+	var claimNames []string
+	for volumeName := range volumeSnapshots {
+		claimNames = append(claimNames, claims[volumeName].Name)
+	}
+	if err := c.deleteWorkspaceVolumeClaims(namespace, claimNames); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace":    namespace,
+			"WorkspaceUID": workspaceUID,
+			"Error":        err.Error(),
+		}).Error("Unable to delete restored volume claims.")
+		return
+	}
+
+	bound := make(map[string]corev1.PersistentVolumeReclaimPolicy)
+	for volumeName := range volumeSnapshots {
+		oldClaim := claims[volumeName]
+		if err := c.rebindVolumeClaim(namespace, workspaceUID, volumeName, &oldClaim); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace":    namespace,
+				"WorkspaceUID": workspaceUID,
+				"Volume":       oldClaim.Spec.VolumeName,
+				"Error":        err.Error(),
+			}).Error("Unable to bind the retained volume again.")
+			continue
+		}
+		bound[oldClaim.Spec.VolumeName] = reclaimPolicies[oldClaim.Spec.VolumeName]
+	}
+
+	c.setVolumeReclaimPolicies(bound)
+}
+
+// rebindVolumeClaim creates the claim again, bound to the claim's released volume
+func (c *Client) rebindVolumeClaim(namespace, workspaceUID, volumeName string, oldClaim *corev1.PersistentVolumeClaim) error {
+	volume, err := c.persistentVolumes().Get(oldClaim.Spec.VolumeName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	volume.Spec.ClaimRef = nil
+	if _, err := c.persistentVolumes().Update(volume); err != nil {
+		return err
+	}
+
+	claim := newWorkspaceVolumeClaim(oldClaim, volumeName, workspaceUID)
+	claim.Spec.VolumeName = oldClaim.Spec.VolumeName
+	_, err = c.CoreV1().PersistentVolumeClaims(namespace).Create(claim)
+
+	return err
+}
+
+// persistentVolumes returns the client of PersistentVolumes. They are cluster-scoped, which users can not change,
+// so the server's client is used for the volumes of the workspace's claims.
+func (c *Client) persistentVolumes() corev1client.PersistentVolumeInterface {
+	return c.SystemKubernetes().CoreV1().PersistentVolumes()
+}
+
+// retainVolume sets the reclaim policy of the volume to Retain, so deleting its claim keeps the data, and returns the previous policy
+func (c *Client) retainVolume(name string) (policy corev1.PersistentVolumeReclaimPolicy, err error) {
+	volume, err := c.persistentVolumes().Get(name, metav1.GetOptions{})
+	if err != nil {
+		return
+	}
+
+	policy = volume.Spec.PersistentVolumeReclaimPolicy
+	if policy == corev1.PersistentVolumeReclaimRetain {
+		return
+	}
+	volume.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimRetain
+	_, err = c.persistentVolumes().Update(volume)
+
+	return
+}
+
+// setVolumeReclaimPolicies sets the reclaim policies of the volumes, by volume name, errors are only logged
+func (c *Client) setVolumeReclaimPolicies(policies map[string]corev1.PersistentVolumeReclaimPolicy) {
+	for name, policy := range policies {
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			volume, err := c.persistentVolumes().Get(name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			if volume.Spec.PersistentVolumeReclaimPolicy == policy {
+				return nil
+			}
+			volume.Spec.PersistentVolumeReclaimPolicy = policy
+			_, err = c.persistentVolumes().Update(volume)
+
+			return err
+		})
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Name":   name,
+				"Policy": policy,
+				"Error":  err.Error(),
+			}).Error("Unable to set volume reclaim policy.")
+		}
+	}
+}
+
+// deleteWorkspaceVolumeClaims deletes the claims and waits until they are all gone, so claims with the same names can be created
+func (c *Client) deleteWorkspaceVolumeClaims(namespace string, names []string) error {
+	claims := c.CoreV1().PersistentVolumeClaims(namespace)
+	for _, name := range names {
+		if err := claims.Delete(name, &metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return wait.PollImmediate(time.Second, workspaceVolumeDeleteTimeout, func() (bool, error) {
+		for _, name := range names {
+			_, err := claims.Get(name, metav1.GetOptions{})
+			if err == nil {
+				return false, nil
+			}
+			if !errors.IsNotFound(err) {
+				return false, err
+			}
+		}
+
+		return true, nil
+	})
+}
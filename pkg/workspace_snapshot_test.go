@@ -0,0 +1,136 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"testing"
+)
+
+// newTestRestoreSource returns the bound data and sys-home claims of the jupyterlab workspace and their volumes
+func newTestRestoreSource() []runtime.Object {
+	var objects []runtime.Object
+	for _, volumeName := range []string{"data", "sys-home"} {
+		claim := newTestVolumeClaim(volumeName, "jupyterlab")
+		claim.Spec.VolumeName = "pv-" + volumeName
+		volume := &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: claim.Spec.VolumeName,
+			},
+			Spec: corev1.PersistentVolumeSpec{
+				PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete,
+				ClaimRef: &corev1.ObjectReference{
+					Namespace: claim.Namespace,
+					Name:      claim.Name,
+				},
+			},
+		}
+		objects = append(objects, claim, volume)
+	}
+
+	return objects
+}
+
+// Test_restoreWorkspaceVolumes makes sure the claims are restored from the snapshots and the old volumes keep their reclaim policy
+func Test_restoreWorkspaceVolumes(t *testing.T) {
+	c := &Client{Interface: fake.NewSimpleClientset(newTestRestoreSource()...)}
+	claims, err := c.listWorkspaceVolumeClaims("onepanel", "jupyterlab")
+	assert.Nil(t, err)
+
+	volumeSnapshots := map[string]string{"data": "snapshot-data", "sys-home": "snapshot-home"}
+	assert.Nil(t, c.restoreWorkspaceVolumes("onepanel", "jupyterlab", claims, volumeSnapshots))
+
+	for volumeName, name := range volumeSnapshots {
+		claim, err := c.CoreV1().PersistentVolumeClaims("onepanel").Get(workspaceVolumeClaimName(volumeName, "jupyterlab"), metav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, name, claim.Spec.DataSource.Name)
+		assert.Empty(t, claim.Spec.VolumeName)
+
+		volume, err := c.CoreV1().PersistentVolumes().Get("pv-"+volumeName, metav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, corev1.PersistentVolumeReclaimDelete, volume.Spec.PersistentVolumeReclaimPolicy)
+	}
+}
+
+// Test_restoreWorkspaceVolumes_SystemKubernetes makes sure the volumes are changed with the server's client, users can not change them
+func Test_restoreWorkspaceVolumes_SystemKubernetes(t *testing.T) {
+	userClient := fake.NewSimpleClientset(newTestRestoreSource()...)
+	userClient.PrependReactor("*", "persistentvolumes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("persistentvolumes is forbidden")
+	})
+	systemClient := fake.NewSimpleClientset(newTestRestoreSource()...)
+	SetSystemKubernetes(systemClient)
+	defer SetSystemKubernetes(nil)
+
+	c := &Client{Interface: userClient}
+	claims, err := c.listWorkspaceVolumeClaims("onepanel", "jupyterlab")
+	assert.Nil(t, err)
+	assert.Nil(t, c.restoreWorkspaceVolumes("onepanel", "jupyterlab", claims, map[string]string{"data": "snapshot-data"}))
+
+	updates := 0
+	for _, action := range systemClient.Actions() {
+		if action.GetVerb() == "update" && action.GetResource().Resource == "persistentvolumes" {
+			updates++
+		}
+	}
+	assert.Equal(t, 2, updates)
+}
+
+// Test_restoreWorkspaceVolumes_Failed makes sure the old claims are bound to the old volumes again if a restored claim can not be created
+func Test_restoreWorkspaceVolumes_Failed(t *testing.T) {
+	clientset := fake.NewSimpleClientset(newTestRestoreSource()...)
+	clientset.PrependReactor("create", "persistentvolumeclaims", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		claim := action.(k8stesting.CreateAction).GetObject().(*corev1.PersistentVolumeClaim)
+		if claim.Spec.DataSource != nil && claim.Spec.DataSource.Name == "snapshot-home" {
+			return true, nil, fmt.Errorf("exceeded quota")
+		}
+
+		return false, nil, nil
+	})
+	// Retaining the volume is checked when the claims are deleted
+	clientset.PrependReactor("delete", "persistentvolumeclaims", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		for _, volumeName := range []string{"data", "sys-home"} {
+			volume, err := clientset.Tracker().Get(corev1.SchemeGroupVersion.WithResource("persistentvolumes"), "", "pv-"+volumeName)
+			assert.Nil(t, err)
+			assert.Equal(t, corev1.PersistentVolumeReclaimRetain, volume.(*corev1.PersistentVolume).Spec.PersistentVolumeReclaimPolicy)
+		}
+
+		return false, nil, nil
+	})
+
+	c := &Client{Interface: clientset}
+	claims, err := c.listWorkspaceVolumeClaims("onepanel", "jupyterlab")
+	assert.Nil(t, err)
+
+	err = c.restoreWorkspaceVolumes("onepanel", "jupyterlab", claims, map[string]string{"data": "snapshot-data", "sys-home": "snapshot-home"})
+	assert.NotNil(t, err)
+
+	for _, volumeName := range []string{"data", "sys-home"} {
+		claim, err := c.CoreV1().PersistentVolumeClaims("onepanel").Get(workspaceVolumeClaimName(volumeName, "jupyterlab"), metav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Nil(t, claim.Spec.DataSource)
+		assert.Equal(t, "pv-"+volumeName, claim.Spec.VolumeName)
+
+		volume, err := c.CoreV1().PersistentVolumes().Get("pv-"+volumeName, metav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Nil(t, volume.Spec.ClaimRef)
+		assert.Equal(t, corev1.PersistentVolumeReclaimDelete, volume.Spec.PersistentVolumeReclaimPolicy)
+	}
+}
+
+// Test_restoreWorkspaceVolumes_Unbound makes sure nothing is deleted if a claim has no volume to retain
+func Test_restoreWorkspaceVolumes_Unbound(t *testing.T) {
+	c := &Client{Interface: fake.NewSimpleClientset(newTestVolumeClaim("data", "jupyterlab"))}
+	claims, err := c.listWorkspaceVolumeClaims("onepanel", "jupyterlab")
+	assert.Nil(t, err)
+
+	err = c.restoreWorkspaceVolumes("onepanel", "jupyterlab", claims, map[string]string{"data": "snapshot-data"})
+	assert.NotNil(t, err)
+
+	_, err = c.CoreV1().PersistentVolumeClaims("onepanel").Get("data-jupyterlab-0", metav1.GetOptions{})
+	assert.Nil(t, err)
+}
@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"encoding/json"
+	"github.com/onepanelio/core/pkg/util/sql"
+	"github.com/onepanelio/core/pkg/util/types"
+	uid2 "github.com/onepanelio/core/pkg/util/uid"
+	"time"
+)
+
+// WorkspaceSnapshot is a checkpoint of the volumes of a workspace, made of a VolumeSnapshot of each volume
+type WorkspaceSnapshot struct {
+	ID           uint64
+	UID          string
+	Name         string
+	Namespace    string
+	WorkspaceID  uint64 `db:"workspace_id"`
+	WorkspaceUID string `db:"workspace_uid"`
+	// VolumeSnapshots are the names of the VolumeSnapshots by the name of the volume they are of
+	VolumeSnapshots      map[string]string
+	VolumeSnapshotsBytes []byte `db:"volume_snapshots"` // to load from database
+	Labels               types.JSONLabels
+	CreatedAt            time.Time `db:"created_at"`
+	// Ready is true once the workspace can be restored from every VolumeSnapshot, Error is why one of them failed.
+	// They are loaded from the VolumeSnapshots, not the database.
+	Ready bool
+	Error string
+}
+
+// GenerateUID generates a uid from the input name and sets it on the snapshot
+func (s *WorkspaceSnapshot) GenerateUID(name string) error {
+	result, err := uid2.GenerateUID(name, 30)
+	if err != nil {
+		return err
+	}
+
+	s.UID = result
+
+	return nil
+}
+
+// LoadFromBytes loads VolumeSnapshots from its database field
+func (s *WorkspaceSnapshot) LoadFromBytes() error {
+	s.VolumeSnapshots = make(map[string]string)
+
+	return json.Unmarshal(s.VolumeSnapshotsBytes, &s.VolumeSnapshots)
+}
+
+// getWorkspaceSnapshotColumns returns all of the columns for WorkspaceSnapshot modified by alias, destination.
+// see formatColumnSelect
+func getWorkspaceSnapshotColumns(aliasAndDestination ...string) []string {
+	columns := []string{"id", "uid", "name", "namespace", "workspace_id", "volume_snapshots", "labels", "created_at"}
+	return sql.FormatColumnSelect(columns, aliasAndDestination...)
+}
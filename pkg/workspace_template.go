@@ -867,6 +867,11 @@ func (c *Client) generateWorkspaceTemplateWorkflowTemplate(workspaceTemplate *Wo
 	if err != nil {
 		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
 	}
+	if workspaceSpec.IdlePolicy != nil {
+		if err := workspaceSpec.IdlePolicy.Validate(); err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+		}
+	}
 
 	if err = generateArguments(workspaceSpec, config); err != nil {
 		return nil, err
@@ -1074,9 +1079,9 @@ func (c *Client) ListWorkspaceTemplates(namespace string, paginator *pagination.
 		Where(sq.Eq{
 			"wt.is_archived": false,
 		}).
-		OrderBy("wt.created_at DESC")
+		OrderBy("wt.created_at DESC", "wt.id DESC")
 
-	sb = *paginator.ApplyToSelect(&sb)
+	sb = *paginator.ApplyToSelect(&sb, "wt.created_at", "wt.id")
 
 	err = c.DB.Selectx(&workspaceTemplates, sb)
 
@@ -15,9 +15,12 @@ type WorkspacePhase string
 
 // Workspace phases
 const (
+	WorkspaceCloning           WorkspacePhase = "Cloning"
 	WorkspaceLaunching         WorkspacePhase = "Launching"
 	WorkspaceRunning           WorkspacePhase = "Running"
 	WorkspaceUpdating          WorkspacePhase = "Updating"
+	WorkspaceResizing          WorkspacePhase = "Resizing"
+	WorkspaceRestoring         WorkspacePhase = "Restoring"
 	WorkspacePausing           WorkspacePhase = "Pausing"
 	WorkspacePaused            WorkspacePhase = "Paused"
 	WorkspaceTerminating       WorkspacePhase = "Terminating"
@@ -35,6 +38,9 @@ type WorkspaceStatus struct {
 	PausedAt     *time.Time     `db:"paused_at"`
 	TerminatedAt *time.Time     `db:"terminated_at"`
 	UpdatedAt    *time.Time     `db:"updated_at"`
+	// Reason explains the phase, such as why the workspace was paused. It is set when an action starts.
+	Reason         string     `db:"reason"`
+	LastActivityAt *time.Time `db:"last_activity_at"`
 }
 
 type Workspace struct {
@@ -52,6 +58,12 @@ type Workspace struct {
 	WorkspaceTemplateID      uint64                   `db:"workspace_template_id"`
 	WorkspaceTemplateVersion uint64                   `db:"workspace_template_version"`
 	WorkflowTemplateVersion  *WorkflowTemplateVersion `db:"workflow_template_version"` // helper to store data from workflow template version
+	Schedule                 *WorkspaceSchedule       `valid:"-"`
+	// PreviousWorkspaceTemplateVersion and PreviousParametersBytes are set while an upgrade can be rolled back
+	PreviousWorkspaceTemplateVersion *int64 `db:"previous_workspace_template_version"`
+	PreviousParametersBytes          []byte `db:"previous_parameters"`
+	// CloneResources is set while a cloned workspace has the snapshots or copy jobs its volumes were created from
+	CloneResources bool `db:"clone_resources"`
 }
 
 type WorkspaceSpec struct {
@@ -61,6 +73,51 @@ type WorkspaceSpec struct {
 	Routes                []*networking.HTTPRoute        `json:"routes" protobuf:"bytes,5,opt,name=routes"`
 	VolumeClaimTemplates  []corev1.PersistentVolumeClaim `json:"volumeClaimTemplates" protobuf:"bytes,6,opt,name=volumeClaimTemplates"`
 	PostExecutionWorkflow *wfv1.WorkflowTemplateSpec     `json:"postExecutionWorkflow" protobuf:"bytes,7,opt,name=postExecutionWorkflow"`
+	IdlePolicy            *WorkspaceIdlePolicy           `json:"idlePolicy" protobuf:"bytes,8,opt,name=idlePolicy"`
+}
+
+// WorkspaceActivitySource is how the activity of a workspace is detected
+type WorkspaceActivitySource string
+
+// Workspace activity sources
+const (
+	// WorkspaceActivityRoute counts the requests Istio routes to the workspace's service
+	WorkspaceActivityRoute WorkspaceActivitySource = "route"
+	// WorkspaceActivityHeartbeat uses the heartbeats the workspace's containers send to RecordWorkspaceActivity
+	WorkspaceActivityHeartbeat WorkspaceActivitySource = "heartbeat"
+)
+
+// WorkspaceIdlePolicy pauses a running workspace once it has had no activity for Timeout, such as 2h
+type WorkspaceIdlePolicy struct {
+	Timeout        string                  `json:"timeout"`
+	ActivitySource WorkspaceActivitySource `json:"activitySource"`
+}
+
+// GetTimeout parses Timeout, which must be positive
+func (p *WorkspaceIdlePolicy) GetTimeout() (time.Duration, error) {
+	timeout, err := time.ParseDuration(p.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid idlePolicy.timeout: %v", err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("idlePolicy.timeout must be positive")
+	}
+
+	return timeout, nil
+}
+
+// Validate returns an error if the timeout or activity source are invalid
+func (p *WorkspaceIdlePolicy) Validate() error {
+	if _, err := p.GetTimeout(); err != nil {
+		return err
+	}
+
+	switch p.ActivitySource {
+	case WorkspaceActivityRoute, WorkspaceActivityHeartbeat:
+		return nil
+	}
+
+	return fmt.Errorf("idlePolicy.activitySource must be %v or %v", WorkspaceActivityRoute, WorkspaceActivityHeartbeat)
 }
 
 // GetURL returns a url that can be used to access the workspace in a browser.
@@ -96,14 +153,14 @@ func (w *Workspace) GenerateUID(name string) error {
 // getWorkspaceColumns returns all of the columns for workspace modified by alias, destination.
 // see formatColumnSelect
 func getWorkspaceColumns(aliasAndDestination ...string) []string {
-	columns := []string{"id", "created_at", "modified_at", "uid", "name", "namespace", "parameters", "workspace_template_id", "workspace_template_version", "labels"}
+	columns := []string{"id", "created_at", "modified_at", "uid", "name", "namespace", "parameters", "workspace_template_id", "workspace_template_version", "labels", "schedule", "previous_workspace_template_version", "previous_parameters", "clone_resources"}
 	return sql.FormatColumnSelect(columns, aliasAndDestination...)
 }
 
 // getWorkspaceStatusColumns returns all of the columns for WorkspaceStatus modified by alias, destination.
 // see formatColumnSelect
 func getWorkspaceStatusColumns(aliasAndDestination ...string) []string {
-	columns := []string{"phase", "started_at", "paused_at", "terminated_at"}
+	columns := []string{"phase", "started_at", "paused_at", "terminated_at", "reason", "last_activity_at"}
 	return sql.FormatColumnSelect(columns, aliasAndDestination...)
 }
 
@@ -122,3 +179,18 @@ func WorkspacesToIDs(resources []*Workspace) (ids []uint64) {
 
 	return
 }
+
+// WorkspaceLogOptions select the log read by GetWorkspaceLogs
+type WorkspaceLogOptions struct {
+	// PodName defaults to the first pod of the workspace's StatefulSet
+	PodName string
+	// ContainerName defaults to the first container of the pod, or main for the action workflow
+	ContainerName string
+	Follow        bool
+	// TailLines is the number of lines to read from the end of the log, 0 reads the whole log
+	TailLines int64
+	// Previous reads the log of the container's previous run, for example after it crashed
+	Previous bool
+	// Action reads the logs of the workspace's last action workflow, such as a launch or pause, instead of its pods
+	Action bool
+}
@@ -0,0 +1,168 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+// upgradeWorkspaceParameters returns the parameters of a workspace moved to a template version with templateParameters.
+// Every parameter of the template takes its value from parameters, then from the workspace's currentParameters, then from its default.
+// Parameters the template does not have are dropped.
+func upgradeWorkspaceParameters(templateParameters, currentParameters, parameters []Parameter) (result []Parameter) {
+	currentParametersMap := MapParametersByName(currentParameters)
+	parametersMap := MapParametersByName(parameters)
+
+	for _, p := range templateParameters {
+		value := p.Value
+		if current, ok := currentParametersMap[p.Name]; ok {
+			value = current.Value
+		}
+		if param, ok := parametersMap[p.Name]; ok {
+			value = param.Value
+		}
+
+		result = append(result, Parameter{
+			Name:  p.Name,
+			Value: value,
+		})
+	}
+
+	return
+}
+
+// UpgradeWorkspace moves the workspace to the workspace template version, or the latest version if version is 0.
+// parameters override the values of the workspace's parameters, new parameters of the template get their default values.
+// A running workspace is updated with the new version, a paused one gets it when it is resumed.
+// Until the workspace is running with the new version, a failed update or resume rolls it back.
+func (c *Client) UpgradeWorkspace(namespace, uid string, version int64, parameters []Parameter) error {
+	workspace, err := c.GetWorkspace(namespace, uid)
+	if err != nil {
+		return util.NewUserError(codes.Unknown, err.Error())
+	}
+	if workspace == nil {
+		return util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+	if workspace.Status.Phase != WorkspaceRunning && workspace.Status.Phase != WorkspacePaused {
+		return util.NewUserError(codes.FailedPrecondition, "Only running or paused workspaces can be upgraded.")
+	}
+
+	workspaceTemplate, err := c.GetWorkspaceTemplate(namespace, workspace.WorkspaceTemplate.UID, version)
+	if err != nil {
+		return err
+	}
+	if workspaceTemplate == nil {
+		return util.NewUserError(codes.NotFound, "Workspace template version not found.")
+	}
+
+	fromVersion := workspace.WorkspaceTemplate.Version
+	if workspaceTemplate.Version == fromVersion {
+		return util.NewUserError(codes.FailedPrecondition, "Workspace already uses this template version.")
+	}
+
+	templateParameters, err := ParseParametersFromManifest([]byte(workspaceTemplate.WorkflowTemplate.Manifest))
+	if err != nil {
+		return err
+	}
+	currentParameters := workspace.Parameters
+	workspace.Parameters = upgradeWorkspaceParameters(templateParameters, currentParameters, parameters)
+	parametersJSON, err := json.Marshal(workspace.Parameters)
+	if err != nil {
+		return err
+	}
+
+	fields := sq.Eq{
+		"workspace_template_version":          workspaceTemplate.Version,
+		"parameters":                          parametersJSON,
+		"previous_workspace_template_version": fromVersion,
+		"previous_parameters":                 workspace.ParametersBytes,
+	}
+	phase := workspace.Status.Phase
+	if phase == WorkspacePaused {
+		fields["modified_at"] = time.Now().UTC()
+		_, err = sb.Update("workspaces").
+			SetMap(fields).
+			Where(sq.Eq{"id": workspace.ID}).
+			RunWith(c.DB).
+			Exec()
+	} else {
+		status := &WorkspaceStatus{
+			Phase:  WorkspaceUpdating,
+			Reason: fmt.Sprintf("Upgrading to template version %v.", workspaceTemplate.Version),
+		}
+		phase = status.Phase
+		err = c.withWorkspaceQuota(namespace, workspace.Status.Phase, currentParameters, workspace.Parameters, func() error {
+			return c.runWorkspaceAction(namespace, workspace, workspaceTemplate.Version, "update", "apply", status, fields)
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	c.createWorkspaceEvent(&WorkspaceEvent{
+		WorkspaceID: workspace.ID,
+		Type:        WorkspaceEventTemplateUpgraded,
+		Phase:       phase,
+		Details: map[string]interface{}{
+			"fromVersion": fromVersion,
+			"toVersion":   workspaceTemplate.Version,
+			"parameters":  workspace.Parameters,
+		},
+	})
+
+	return nil
+}
+
+// rollbackWorkspaceUpgrade moves a workspace whose upgrade failed back to its previous template version and parameters,
+// and runs the action that failed again. reason is why the upgrade failed.
+func (c *Client) rollbackWorkspaceUpgrade(namespace string, workspace *Workspace, reason string) error {
+	var previousParameters []Parameter
+	if err := json.Unmarshal(workspace.PreviousParametersBytes, &previousParameters); err != nil {
+		return err
+	}
+	currentParameters := workspace.Parameters
+	workspace.Parameters = previousParameters
+
+	fromVersion := workspace.WorkspaceTemplate.Version
+	toVersion := *workspace.PreviousWorkspaceTemplateVersion
+
+	workspaceAction := "update"
+	status := &WorkspaceStatus{Phase: WorkspaceUpdating}
+	if workspace.Status.Phase == WorkspaceFailedToResume {
+		workspaceAction = "create"
+		status.Phase = WorkspaceLaunching
+	}
+	status.Reason = fmt.Sprintf("Rolling back the upgrade to template version %v.", fromVersion)
+
+	rollback := func() error {
+		return c.runWorkspaceAction(namespace, workspace, toVersion, workspaceAction, "apply", status, sq.Eq{
+			"workspace_template_version":          toVersion,
+			"parameters":                          workspace.PreviousParametersBytes,
+			"previous_workspace_template_version": nil,
+			"previous_parameters":                 nil,
+		})
+	}
+
+	// A workspace that failed to resume does not count towards the quota, resuming it again must fit in it
+	err := c.withWorkspaceQuota(namespace, workspace.Status.Phase, currentParameters, previousParameters, rollback)
+	if err != nil {
+		return err
+	}
+
+	c.createWorkspaceEvent(&WorkspaceEvent{
+		WorkspaceID: workspace.ID,
+		Type:        WorkspaceEventTemplateUpgraded,
+		Phase:       status.Phase,
+		Reason:      reason,
+		Details: map[string]interface{}{
+			"fromVersion": fromVersion,
+			"toVersion":   toVersion,
+			"rollback":    true,
+		},
+	})
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// Test_upgradeWorkspaceParameters makes sure passed in values win over current values, which win over the new template's defaults,
+// and that parameters the new template does not have are dropped
+func Test_upgradeWorkspaceParameters(t *testing.T) {
+	templateParameters := []Parameter{
+		{Name: "image", Value: ptr.String("jupyter:v2")},
+		{Name: "sys-node-pool", Value: ptr.String("small")},
+		{Name: "cpu", Value: ptr.String("2")},
+	}
+	currentParameters := []Parameter{
+		{Name: "image", Value: ptr.String("jupyter:v1")},
+		{Name: "sys-node-pool", Value: ptr.String("large")},
+		{Name: "gpu", Value: ptr.String("1")},
+	}
+	parameters := []Parameter{
+		{Name: "image", Value: ptr.String("jupyter:v3")},
+	}
+
+	result := upgradeWorkspaceParameters(templateParameters, currentParameters, parameters)
+
+	assert.Len(t, result, 3)
+	assert.Equal(t, "image", result[0].Name)
+	assert.Equal(t, "jupyter:v3", *result[0].Value)
+	assert.Equal(t, "sys-node-pool", result[1].Name)
+	assert.Equal(t, "large", *result[1].Value)
+	assert.Equal(t, "cpu", result[2].Name)
+	assert.Equal(t, "2", *result[2].Value)
+}
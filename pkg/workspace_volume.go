@@ -0,0 +1,298 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"strings"
+	"time"
+)
+
+// workspaceVolumeResizeTimeout is how long a workspace can be resizing before it is given up on
+var workspaceVolumeResizeTimeout = 30 * time.Minute
+
+// workspaceVolumeSizeParameterName is the name of the parameter with the size of the volume in MiB
+func workspaceVolumeSizeParameterName(volumeName string) string {
+	return fmt.Sprintf("sys-%v-volume-size", volumeName)
+}
+
+// ResizeWorkspaceVolume expands the volume of the workspace to size MiB.
+// The size parameter of a paused workspace is set right away, a running workspace's is set when it is resumed,
+// because the volumeClaimTemplates of its StatefulSet can not be updated.
+// The workspace is Resizing until the volume has been expanded, a running workspace is restarted if its file system
+// can only be expanded while it is mounted.
+func (c *Client) ResizeWorkspaceVolume(namespace, uid, volumeName string, size int64) error {
+	workspace, err := c.GetWorkspace(namespace, uid)
+	if err != nil {
+		return util.NewUserError(codes.Unknown, err.Error())
+	}
+	if workspace == nil {
+		return util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+	if workspace.Status.Phase != WorkspaceRunning && workspace.Status.Phase != WorkspacePaused {
+		return util.NewUserError(codes.FailedPrecondition, "Only the volumes of running or paused workspaces can be resized.")
+	}
+
+	claims, err := c.listWorkspaceVolumeClaims(namespace, uid)
+	if err != nil {
+		return err
+	}
+	claim, ok := claims[volumeName]
+	if !ok {
+		return util.NewUserError(codes.NotFound, "Volume not found.")
+	}
+
+	quantity := resource.NewQuantity(size*1024*1024, resource.BinarySI)
+	current := claim.Spec.Resources.Requests[corev1.ResourceStorage]
+	if quantity.Cmp(current) <= 0 {
+		return util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Volumes can only be expanded, the size must be larger than %v.", current.String()))
+	}
+
+	if claim.Spec.StorageClassName == nil {
+		return util.NewUserError(codes.FailedPrecondition, "Volume has no storage class, it can not be expanded.")
+	}
+	storageClass, err := c.StorageV1().StorageClasses().Get(*claim.Spec.StorageClassName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if storageClass.AllowVolumeExpansion == nil || !*storageClass.AllowVolumeExpansion {
+		return util.NewUserError(codes.FailedPrecondition, fmt.Sprintf("Storage class %v does not allow volume expansion.", storageClass.Name))
+	}
+
+	update := updateWorkspaceStatusBuilder(namespace, uid, &WorkspaceStatus{
+		Phase:  WorkspaceResizing,
+		Reason: fmt.Sprintf("Resizing volume %v to %v.", volumeName, quantity.String()),
+	})
+	sizeParameterNames, err := workspaceVolumeSizeParameterNames(workspace)
+	if err != nil {
+		return err
+	}
+	parameterName := workspaceVolumeSizeParameterName(volumeName)
+	if sizeParameterNames[parameterName] && workspace.Status.Phase == WorkspacePaused {
+		workspace.Parameters = mergeWorkspaceParameters(workspace.Parameters, []Parameter{
+			{
+				Name:  parameterName,
+				Value: ptr.String(fmt.Sprintf("%v", size)),
+			},
+		})
+		parametersJSON, err := json.Marshal(workspace.Parameters)
+		if err != nil {
+			return err
+		}
+		update = update.Set("parameters", parametersJSON)
+	}
+
+	if claim.Spec.Resources.Requests == nil {
+		claim.Spec.Resources.Requests = corev1.ResourceList{}
+	}
+	claim.Spec.Resources.Requests[corev1.ResourceStorage] = *quantity
+	if _, err := c.CoreV1().PersistentVolumeClaims(namespace).Update(&claim); err != nil {
+		return err
+	}
+
+	if _, err := update.RunWith(c.DB).Exec(); err != nil {
+		return err
+	}
+
+	c.createWorkspacePhaseEvent(workspace, WorkspaceResizing, "")
+	c.createWorkspaceEvent(&WorkspaceEvent{
+		WorkspaceID: workspace.ID,
+		Type:        WorkspaceEventVolumeResized,
+		Phase:       WorkspaceResizing,
+		Details: map[string]interface{}{
+			"volume": volumeName,
+			"size":   quantity.String(),
+		},
+	})
+
+	return nil
+}
+
+// workspaceVolumeSizeParameterNames returns the names of the size parameters of the workspace's template.
+// Only the template knows if the size is a parameter, volumes with a fixed size have none.
+func workspaceVolumeSizeParameterNames(workspace *Workspace) (names map[string]bool, err error) {
+	templateParameters, err := ParseParametersFromManifest([]byte(workspace.WorkflowTemplateVersion.Manifest))
+	if err != nil {
+		return nil, err
+	}
+
+	names = make(map[string]bool)
+	for _, p := range templateParameters {
+		if strings.HasPrefix(p.Name, "sys-") && strings.HasSuffix(p.Name, "-volume-size") {
+			names[p.Name] = true
+		}
+	}
+
+	return
+}
+
+// workspaceVolumeSizeParameters returns the size parameters of the workspace's volumes set to the sizes their claims request,
+// so the StatefulSet that is created when the workspace is resumed has the sizes of volumes resized while it was running.
+func (c *Client) workspaceVolumeSizeParameters(namespace string, workspace *Workspace) (parameters []Parameter, err error) {
+	sizeParameterNames, err := workspaceVolumeSizeParameterNames(workspace)
+	if err != nil || len(sizeParameterNames) == 0 {
+		return
+	}
+
+	claims, err := c.listWorkspaceVolumeClaims(namespace, workspace.UID)
+	if err != nil {
+		return
+	}
+	for volumeName, claim := range claims {
+		parameterName := workspaceVolumeSizeParameterName(volumeName)
+		if !sizeParameterNames[parameterName] {
+			continue
+		}
+		requested, ok := claim.Spec.Resources.Requests[corev1.ResourceStorage]
+		if !ok {
+			continue
+		}
+
+		parameters = append(parameters, Parameter{
+			Name:  parameterName,
+			Value: ptr.String(fmt.Sprintf("%v", requested.Value()/(1024*1024))),
+		})
+	}
+
+	return
+}
+
+// workspaceVolumeResizeStatus returns whether the claim has the capacity it requests.
+// If the file system is only expanded once the volume is mounted again, fileSystemResizePendingSince is when that started.
+func workspaceVolumeResizeStatus(claim *corev1.PersistentVolumeClaim) (done bool, fileSystemResizePendingSince *metav1.Time) {
+	for _, condition := range claim.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		if condition.Type == corev1.PersistentVolumeClaimFileSystemResizePending {
+			return false, &condition.LastTransitionTime
+		}
+		if condition.Type == corev1.PersistentVolumeClaimResizing {
+			return false, nil
+		}
+	}
+
+	requested := claim.Spec.Resources.Requests[corev1.ResourceStorage]
+	capacity := claim.Status.Capacity[corev1.ResourceStorage]
+
+	return capacity.Cmp(requested) >= 0, nil
+}
+
+// ReconcileResizingWorkspaces moves the workspaces whose volumes have been expanded back to Running or Paused,
+// and restarts running workspaces whose file systems need it to be expanded.
+func (c *Client) ReconcileResizingWorkspaces() error {
+	query := sb.Select("namespace", "uid").
+		From("workspaces").
+		Where(sq.Eq{
+			"phase": WorkspaceResizing,
+		}).
+		OrderBy("id")
+
+	workspaces := make([]*Workspace, 0)
+	if err := c.DB.Selectx(&workspaces, query); err != nil {
+		return err
+	}
+
+	for _, w := range workspaces {
+		if err := c.reconcileWorkspaceResize(w.Namespace, w.UID); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": w.Namespace,
+				"UID":       w.UID,
+				"Error":     err.Error(),
+			}).Error("Unable to reconcile workspace resize.")
+		}
+	}
+
+	return nil
+}
+
+// reconcileWorkspaceResize finishes the resize of the workspace once every volume has been expanded
+func (c *Client) reconcileWorkspaceResize(namespace, uid string) error {
+	workspace, err := c.GetWorkspace(namespace, uid)
+	if err != nil {
+		return err
+	}
+	if workspace == nil || workspace.Status.Phase != WorkspaceResizing {
+		return nil
+	}
+
+	claims, err := c.listWorkspaceVolumeClaims(namespace, uid)
+	if err != nil {
+		return err
+	}
+
+	// A paused workspace has no pods, the file systems are expanded when it is resumed
+	paused := workspace.Status.PausedAt != nil
+	done := true
+	for volumeName, claim := range claims {
+		claimDone, fileSystemResizePendingSince := workspaceVolumeResizeStatus(&claim)
+		if fileSystemResizePendingSince != nil && !paused {
+			restarted, err := c.restartWorkspacePods(namespace, uid, fileSystemResizePendingSince)
+			if err != nil {
+				return err
+			}
+			if restarted {
+				_, err := sb.Update("workspaces").
+					Set("reason", fmt.Sprintf("Restarting to resize the file system of volume %v.", volumeName)).
+					Where(sq.Eq{"id": workspace.ID}).
+					RunWith(c.DB).
+					Exec()
+				if err != nil {
+					return err
+				}
+			}
+		}
+		done = done && (claimDone || (fileSystemResizePendingSince != nil && paused))
+	}
+
+	reason := ""
+	if !done {
+		if workspace.ModifiedAt == nil || time.Since(*workspace.ModifiedAt) < workspaceVolumeResizeTimeout {
+			return nil
+		}
+		reason = "Volume resize did not complete in time."
+	}
+
+	status := &WorkspaceStatus{Phase: WorkspaceRunning}
+	if paused {
+		status.Phase = WorkspacePaused
+	}
+	_, err = updateWorkspaceStatusBuilder(namespace, uid, status).
+		Set("reason", reason).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+	c.createWorkspacePhaseEvent(workspace, status.Phase, reason)
+
+	return nil
+}
+
+// restartWorkspacePods deletes the workspace's pods created before the time, so its StatefulSet recreates them.
+// restarted is true if any were deleted.
+func (c *Client) restartWorkspacePods(namespace, uid string, before *metav1.Time) (restarted bool, err error) {
+	pods, err := c.listWorkspacePods(namespace, uid)
+	if err != nil {
+		return false, err
+	}
+
+	for _, pod := range pods {
+		if !pod.CreationTimestamp.Before(before) {
+			continue
+		}
+		if err := c.CoreV1().Pods(namespace).Delete(pod.Name, &metav1.DeleteOptions{}); err != nil {
+			return false, err
+		}
+		restarted = true
+	}
+
+	return
+}
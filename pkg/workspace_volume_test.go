@@ -0,0 +1,172 @@
+package v1
+
+import (
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"testing"
+)
+
+func Test_workspaceVolumeResizeStatus(t *testing.T) {
+	done, pendingSince := workspaceVolumeResizeStatus(newTestResizedVolumeClaim("data", "jupyterlab", "20Gi", corev1.PersistentVolumeClaimResizing))
+	assert.False(t, done)
+	assert.Nil(t, pendingSince)
+
+	done, pendingSince = workspaceVolumeResizeStatus(newTestResizedVolumeClaim("data", "jupyterlab", "20Gi", corev1.PersistentVolumeClaimFileSystemResizePending))
+	assert.False(t, done)
+	assert.NotNil(t, pendingSince)
+
+	done, pendingSince = workspaceVolumeResizeStatus(newTestResizedVolumeClaim("data", "jupyterlab", "20Gi", ""))
+	assert.False(t, done)
+	assert.Nil(t, pendingSince)
+
+	done, pendingSince = workspaceVolumeResizeStatus(newTestResizedVolumeClaim("data", "jupyterlab", "40Gi", ""))
+	assert.True(t, done)
+	assert.Nil(t, pendingSince)
+}
+
+func Test_workspaceVolumeSizeParameterName(t *testing.T) {
+	assert.Equal(t, "sys-data-volume-size", workspaceVolumeSizeParameterName("data"))
+}
+
+// Test_workspaceVolumeSizeParameters makes sure only the volumes whose size is a parameter of the template get one, in MiB
+func Test_workspaceVolumeSizeParameters(t *testing.T) {
+	data := newTestVolumeClaim("data", "jupyterlab")
+	data.Spec.Resources.Requests = corev1.ResourceList{
+		corev1.ResourceStorage: resource.MustParse("40Gi"),
+	}
+	c := &Client{
+		Interface: fake.NewSimpleClientset(data, newTestResizedVolumeClaim("home", "jupyterlab", "40Gi", "")),
+	}
+	workspace := &Workspace{
+		UID: "jupyterlab",
+		WorkflowTemplateVersion: &WorkflowTemplateVersion{
+			Manifest: `arguments:
+  parameters:
+  - name: sys-data-volume-size
+    value: 20480
+  - name: sys-name
+    value: jupyterlab
+`,
+		},
+	}
+
+	parameters, err := c.workspaceVolumeSizeParameters("onepanel", workspace)
+	assert.Nil(t, err)
+	assert.Equal(t, []Parameter{{Name: "sys-data-volume-size", Value: ptr.String("40960")}}, parameters)
+}
+
+// newTestResizeSource returns the claim of the data volume of the jupyterlab workspace and its expandable storage class
+func newTestResizeSource() []runtime.Object {
+	data := newTestVolumeClaim("data", "jupyterlab")
+	data.Spec.StorageClassName = ptr.String("csi")
+	data.Spec.Resources.Requests = corev1.ResourceList{
+		corev1.ResourceStorage: resource.MustParse("20Gi"),
+	}
+	storageClass := newTestStorageClass("csi", "ebs.csi.aws.com")
+	storageClass.AllowVolumeExpansion = ptr.Bool(true)
+
+	return []runtime.Object{data, storageClass, mockSystemConfigMap, mockSystemSecret}
+}
+
+// setTestVolumeCapacity sets the capacity of the claim of the volume of the jupyterlab workspace
+func setTestVolumeCapacity(t *testing.T, c *Client, volumeName, capacity string) {
+	claim, err := c.CoreV1().PersistentVolumeClaims("onepanel").Get(workspaceVolumeClaimName(volumeName, "jupyterlab"), metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	claim.Status.Capacity = corev1.ResourceList{
+		corev1.ResourceStorage: resource.MustParse(capacity),
+	}
+	if _, err := c.CoreV1().PersistentVolumeClaims("onepanel").UpdateStatus(claim); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestClient_ResizeWorkspaceVolume makes sure a running workspace keeps its size parameter, so its StatefulSet can still be applied,
+// and is running again once the volume is expanded
+func TestClient_ResizeWorkspaceVolume(t *testing.T) {
+	c := NewTestClient(database, newTestResizeSource()...)
+	clearDatabase(t)
+	createTestCloneSource(t, c)
+
+	err := c.ResizeWorkspaceVolume("onepanel", "jupyterlab", "data", 10240)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).Code)
+
+	err = c.ResizeWorkspaceVolume("onepanel", "jupyterlab", "missing", 40960)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).Code)
+
+	assert.Nil(t, c.ResizeWorkspaceVolume("onepanel", "jupyterlab", "data", 40960))
+
+	claim, err := c.CoreV1().PersistentVolumeClaims("onepanel").Get("data-jupyterlab-0", metav1.GetOptions{})
+	assert.Nil(t, err)
+	requested := claim.Spec.Resources.Requests[corev1.ResourceStorage]
+	assert.Equal(t, "40Gi", requested.String())
+
+	workspace, err := c.GetWorkspace("onepanel", "jupyterlab")
+	assert.Nil(t, err)
+	assert.Equal(t, WorkspaceResizing, workspace.Status.Phase)
+	for _, p := range workspace.Parameters {
+		assert.NotEqual(t, "sys-data-volume-size", p.Name)
+	}
+
+	// The volume is still being expanded
+	assert.Nil(t, c.reconcileWorkspaceResize("onepanel", "jupyterlab"))
+	workspace, err = c.GetWorkspace("onepanel", "jupyterlab")
+	assert.Nil(t, err)
+	assert.Equal(t, WorkspaceResizing, workspace.Status.Phase)
+
+	setTestVolumeCapacity(t, c, "data", "40Gi")
+	assert.Nil(t, c.reconcileWorkspaceResize("onepanel", "jupyterlab"))
+	workspace, err = c.GetWorkspace("onepanel", "jupyterlab")
+	assert.Nil(t, err)
+	assert.Equal(t, WorkspaceRunning, workspace.Status.Phase)
+	assert.Empty(t, workspace.Status.Reason)
+
+	// The size is set when the workspace is resumed
+	parameters, err := c.workspaceVolumeSizeParameters("onepanel", workspace)
+	assert.Nil(t, err)
+	assert.Equal(t, []Parameter{{Name: "sys-data-volume-size", Value: ptr.String("40960")}}, parameters)
+}
+
+// TestClient_ResizeWorkspaceVolume_Paused makes sure a paused workspace's size parameter is set right away,
+// and it is paused again once the volume is expanded, even if its file system is only expanded when it is mounted
+func TestClient_ResizeWorkspaceVolume_Paused(t *testing.T) {
+	c := NewTestClient(database, newTestResizeSource()...)
+	clearDatabase(t)
+	createTestCloneSource(t, c)
+	for _, phase := range []WorkspacePhase{WorkspacePausing, WorkspacePaused} {
+		_, err := updateWorkspaceStatusBuilder("onepanel", "jupyterlab", &WorkspaceStatus{Phase: phase}).
+			RunWith(c.DB).
+			Exec()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assert.Nil(t, c.ResizeWorkspaceVolume("onepanel", "jupyterlab", "data", 40960))
+
+	workspace, err := c.GetWorkspace("onepanel", "jupyterlab")
+	assert.Nil(t, err)
+	assert.Equal(t, WorkspaceResizing, workspace.Status.Phase)
+	assert.Contains(t, workspace.Parameters, Parameter{Name: "sys-data-volume-size", Value: ptr.String("40960")})
+
+	claim, err := c.CoreV1().PersistentVolumeClaims("onepanel").Get("data-jupyterlab-0", metav1.GetOptions{})
+	assert.Nil(t, err)
+	claim.Status.Conditions = []corev1.PersistentVolumeClaimCondition{
+		{Type: corev1.PersistentVolumeClaimFileSystemResizePending, Status: corev1.ConditionTrue, LastTransitionTime: metav1.Now()},
+	}
+	_, err = c.CoreV1().PersistentVolumeClaims("onepanel").UpdateStatus(claim)
+	assert.Nil(t, err)
+
+	assert.Nil(t, c.reconcileWorkspaceResize("onepanel", "jupyterlab"))
+	workspace, err = c.GetWorkspace("onepanel", "jupyterlab")
+	assert.Nil(t, err)
+	assert.Equal(t, WorkspacePaused, workspace.Status.Phase)
+}
@@ -0,0 +1,356 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/onepanelio/core/api"
+	v1 "github.com/onepanelio/core/pkg"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// artifactChunkSize is the number of bytes sent in each chunk of an artifact download
+const artifactChunkSize = 1024 * 1024
+
+// artifactDownloadPath matches /apis/v1beta1/{namespace}/workflow_executions/{uid}/download/{key}
+// and /apis/v1beta1/{namespace}/workflow_executions/{uid}/download_archive/{prefix}
+var artifactDownloadPath = regexp.MustCompile(`^/apis/v1beta1/([^/]+)/workflow_executions/([^/]+)/(download|download_archive)/(.+)$`)
+
+// logDownloadPath matches /apis/v1beta1/{namespace}/workflow_executions/{uid}/pods/{podName}/containers/{containerName}/logs/download
+var logDownloadPath = regexp.MustCompile(`^/apis/v1beta1/([^/]+)/workflow_executions/([^/]+)/pods/([^/]+)/containers/([^/]+)/logs/download$`)
+
+// ArtifactDownloadHandler serves artifact, directory and log downloads over plain HTTP by streaming them from the
+// DownloadArtifact, DownloadArtifactArchive and DownloadWorkflowExecutionLogs RPCs.
+// Unlike the grpc-gateway, the response body is the raw artifact, and Range requests are supported for artifacts.
+type ArtifactDownloadHandler struct {
+	client api.WorkflowServiceClient
+	next   http.Handler
+}
+
+// NewArtifactDownloadHandler creates a handler that serves artifact downloads and passes every other request to next
+func NewArtifactDownloadHandler(client api.WorkflowServiceClient, next http.Handler) *ArtifactDownloadHandler {
+	return &ArtifactDownloadHandler{
+		client: client,
+		next:   next,
+	}
+}
+
+// parseRangeHeader parses a Range header with a single byte range into the offset and length of a DownloadArtifactRequest.
+// ok is false if the header is missing or not supported, in which case the whole artifact is sent.
+func parseRangeHeader(value string) (offset, length int64, ok bool) {
+	if !strings.HasPrefix(value, "bytes=") {
+		return 0, 0, false
+	}
+
+	spec := strings.TrimSpace(strings.TrimPrefix(value, "bytes="))
+	if strings.Contains(spec, ",") {
+		return 0, 0, false
+	}
+
+	parts := strings.SplitN(spec, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+
+	// bytes=-500 is the last 500 bytes
+	if parts[0] == "" {
+		suffix, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil || suffix <= 0 {
+			return 0, 0, false
+		}
+		return -suffix, 0, true
+	}
+
+	start, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || start < 0 {
+		return 0, 0, false
+	}
+
+	// bytes=500- is everything from byte 500
+	if parts[1] == "" {
+		return start, 0, true
+	}
+
+	end, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || end < start {
+		return 0, 0, false
+	}
+
+	return start, end - start + 1, true
+}
+
+// outgoingContext forwards the credentials of the HTTP request to the RPC server, like the grpc-gateway does
+func outgoingContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		md.Set("authorization", authorization)
+	}
+	if cookie := r.Header.Get("Cookie"); cookie != "" {
+		md.Set("grpcgateway-cookie", cookie)
+	}
+
+	return metadata.NewOutgoingContext(r.Context(), md)
+}
+
+// unescapePathParams unescapes the parameters matched in a path
+func unescapePathParams(matches []string) ([]string, error) {
+	params := make([]string, len(matches))
+	for i, match := range matches {
+		param, err := url.PathUnescape(match)
+		if err != nil {
+			return nil, err
+		}
+		params[i] = param
+	}
+
+	return params, nil
+}
+
+// ServeHTTP streams the artifact, directory or log if the request is a download, otherwise it calls the next handler
+func (h *ArtifactDownloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	path := r.URL.EscapedPath()
+	if matches := logDownloadPath.FindStringSubmatch(path); matches != nil {
+		params, err := unescapePathParams(matches[1:])
+		if err != nil {
+			http.Error(w, "Invalid path.", http.StatusBadRequest)
+			return
+		}
+
+		ctx, cancel := context.WithCancel(outgoingContext(r))
+		defer cancel()
+
+		query := r.URL.Query()
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		h.serveLogs(ctx, w, r, &api.SearchWorkflowExecutionLogsRequest{
+			Namespace:     params[0],
+			Uid:           params[1],
+			PodName:       params[2],
+			ContainerName: params[3],
+			Query:         query.Get("query"),
+			Regex:         query.Get("regex") == "true",
+			Since:         query.Get("since"),
+			Until:         query.Get("until"),
+			Limit:         int32(limit),
+		})
+		return
+	}
+
+	matches := artifactDownloadPath.FindStringSubmatch(path)
+	if matches == nil {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	params, err := unescapePathParams(matches[1:])
+	if err != nil {
+		http.Error(w, "Invalid path.", http.StatusBadRequest)
+		return
+	}
+	namespace, uid, key := params[0], params[1], params[3]
+
+	ctx, cancel := context.WithCancel(outgoingContext(r))
+	defer cancel()
+
+	if params[2] == "download_archive" {
+		h.serveArchive(ctx, w, r, &api.DownloadArtifactArchiveRequest{
+			Namespace: namespace,
+			Uid:       uid,
+			Prefix:    key,
+			Format:    r.URL.Query().Get("format"),
+		})
+		return
+	}
+
+	h.serveArtifact(ctx, w, r, &api.DownloadArtifactRequest{
+		Namespace:  namespace,
+		Uid:        uid,
+		Key:        key,
+		Repository: r.URL.Query().Get("repository"),
+	})
+}
+
+// serveArtifact streams a single artifact, or the byte range requested by the Range header
+func (h *ArtifactDownloadHandler) serveArtifact(ctx context.Context, w http.ResponseWriter, r *http.Request, req *api.DownloadArtifactRequest) {
+	ranged := false
+	if value := r.Header.Get("Range"); value != "" {
+		req.Offset, req.Length, ranged = parseRangeHeader(value)
+	}
+
+	stream, err := h.client.DownloadArtifact(ctx, req)
+	if err != nil {
+		h.writeArtifactRangeError(ctx, w, req, err)
+		return
+	}
+
+	chunk, err := stream.Recv()
+	if err != nil {
+		h.writeArtifactRangeError(ctx, w, req, err)
+		return
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", chunk.ContentType)
+	header.Set("Content-Length", strconv.FormatInt(chunk.Length, 10))
+	header.Set("Accept-Ranges", "bytes")
+	header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": v1.FilePathToName(req.Key),
+	}))
+
+	if ranged && chunk.Length > 0 {
+		header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", chunk.Offset, chunk.Offset+chunk.Length-1, chunk.TotalSize))
+		w.WriteHeader(http.StatusPartialContent)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	writeArtifactChunks(w, chunk, stream, log.Fields{
+		"Namespace": req.Namespace,
+		"UID":       req.Uid,
+		"Key":       req.Key,
+	})
+}
+
+// writeArtifactRangeError writes the error of downloading the artifact. If the requested range is not satisfiable,
+// the Content-Range header has the size of the artifact, which is read by downloading only its last byte.
+func (h *ArtifactDownloadHandler) writeArtifactRangeError(ctx context.Context, w http.ResponseWriter, req *api.DownloadArtifactRequest, err error) {
+	if status.Code(err) == codes.OutOfRange {
+		stream, sizeErr := h.client.DownloadArtifact(ctx, &api.DownloadArtifactRequest{
+			Namespace:  req.Namespace,
+			Uid:        req.Uid,
+			Key:        req.Key,
+			Repository: req.Repository,
+			Offset:     -1,
+		})
+		if sizeErr == nil {
+			if chunk, sizeErr := stream.Recv(); sizeErr == nil {
+				w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", chunk.TotalSize))
+			}
+		}
+	}
+
+	writeArtifactError(w, err)
+}
+
+// serveArchive streams a zip or tar.gz archive of a directory. The size is not known in advance, so there is no Content-Length.
+func (h *ArtifactDownloadHandler) serveArchive(ctx context.Context, w http.ResponseWriter, r *http.Request, req *api.DownloadArtifactArchiveRequest) {
+	stream, err := h.client.DownloadArtifactArchive(ctx, req)
+	if err != nil {
+		writeArtifactError(w, err)
+		return
+	}
+
+	chunk, err := stream.Recv()
+	if err != nil {
+		writeArtifactError(w, err)
+		return
+	}
+
+	format, _ := v1.ArtifactArchiveFormatFromString(req.Format)
+	header := w.Header()
+	header.Set("Content-Type", chunk.ContentType)
+	header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": v1.FilePathToName(req.Prefix) + "." + string(format),
+	}))
+	w.WriteHeader(http.StatusOK)
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	writeArtifactChunks(w, chunk, stream, log.Fields{
+		"Namespace": req.Namespace,
+		"UID":       req.Uid,
+		"Prefix":    req.Prefix,
+	})
+}
+
+// serveLogs streams a container's log as a text file. The size is not known in advance, so there is no Content-Length.
+func (h *ArtifactDownloadHandler) serveLogs(ctx context.Context, w http.ResponseWriter, r *http.Request, req *api.SearchWorkflowExecutionLogsRequest) {
+	stream, err := h.client.DownloadWorkflowExecutionLogs(ctx, req)
+	if err != nil {
+		writeArtifactError(w, err)
+		return
+	}
+
+	chunk, err := stream.Recv()
+	if err != nil {
+		writeArtifactError(w, err)
+		return
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", chunk.ContentType)
+	header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": req.PodName + "-" + req.ContainerName + ".log",
+	}))
+	w.WriteHeader(http.StatusOK)
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	writeArtifactChunks(w, chunk, stream, log.Fields{
+		"Namespace":     req.Namespace,
+		"UID":           req.Uid,
+		"PodName":       req.PodName,
+		"ContainerName": req.ContainerName,
+	})
+}
+
+// artifactChunkReceiver is a stream of ArtifactChunks
+type artifactChunkReceiver interface {
+	Recv() (*api.ArtifactChunk, error)
+}
+
+// writeArtifactChunks writes first and the rest of the chunks in stream to w.
+// The status has already been written, so errors can only be logged.
+func writeArtifactChunks(w http.ResponseWriter, first *api.ArtifactChunk, stream artifactChunkReceiver, fields log.Fields) {
+	chunk := first
+	for {
+		if _, err := w.Write(chunk.Data); err != nil {
+			// The client went away
+			return
+		}
+
+		var err error
+		chunk, err = stream.Recv()
+		if err != nil {
+			if err != io.EOF {
+				fields["Error"] = err.Error()
+				log.WithFields(fields).Error("Artifact download failed.")
+			}
+			return
+		}
+	}
+}
+
+// writeArtifactError writes the HTTP status that corresponds to the error of the DownloadArtifact RPC
+func writeArtifactError(w http.ResponseWriter, err error) {
+	s := status.Convert(err)
+
+	code := runtime.HTTPStatusFromCode(s.Code())
+	if s.Code() == codes.OutOfRange {
+		code = http.StatusRequestedRangeNotSatisfiable
+	}
+
+	http.Error(w, s.Message(), code)
+}
@@ -74,18 +74,28 @@ func getClient(ctx context.Context, kubeConfig *v1.Config, db *v1.DB, sysConfig
 }
 
 func IsAuthorized(c *v1.Client, namespace, verb, group, resource, name string) (allowed bool, err error) {
+	return IsAuthorizedSubresource(c, namespace, verb, group, resource, "", name)
+}
+
+// IsAuthorizedSubresource is IsAuthorized for a subresource, such as workspaces/activity.
+// A subresource can be granted without granting the resource itself.
+func IsAuthorizedSubresource(c *v1.Client, namespace, verb, group, resource, subresource, name string) (allowed bool, err error) {
 	review, err := c.AuthorizationV1().SelfSubjectAccessReviews().Create(&authorizationv1.SelfSubjectAccessReview{
 		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
 			ResourceAttributes: &authorizationv1.ResourceAttributes{
-				Namespace: namespace,
-				Verb:      verb,
-				Group:     group,
-				Resource:  resource,
-				Name:      name,
+				Namespace:   namespace,
+				Verb:        verb,
+				Group:       group,
+				Resource:    resource,
+				Subresource: subresource,
+				Name:        name,
 			},
 		},
 	})
 
+	if subresource != "" {
+		resource += "/" + subresource
+	}
 	deniedMsg := fmt.Sprintf(`Permission denied. Namespace: '%v', Verb: '%v', Group: '%v', Resource '%v', Name: '%v'`, namespace, verb, group, resource, name)
 	if err != nil {
 		return false, status.Error(codes.PermissionDenied, deniedMsg)
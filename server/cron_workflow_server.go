@@ -5,7 +5,6 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/onepanelio/core/api"
 	v1 "github.com/onepanelio/core/pkg"
-	"github.com/onepanelio/core/pkg/util/pagination"
 	"github.com/onepanelio/core/pkg/util/ptr"
 	"github.com/onepanelio/core/server/auth"
 	"github.com/onepanelio/core/server/converter"
@@ -161,8 +160,11 @@ func (c *CronWorkflowServer) ListCronWorkflows(ctx context.Context, req *api.Lis
 		return nil, err
 	}
 
-	paginator := pagination.NewRequest(req.Page, req.PageSize)
-	cronWorkflows, err := client.ListCronWorkflows(req.Namespace, req.WorkflowTemplateName, &paginator)
+	paginator, err := newPaginator(req.Page, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	cronWorkflows, err := client.ListCronWorkflows(req.Namespace, req.WorkflowTemplateName, paginator)
 	if err != nil {
 		return nil, err
 	}
@@ -176,13 +178,19 @@ func (c *CronWorkflowServer) ListCronWorkflows(ctx context.Context, req *api.Lis
 		return nil, err
 	}
 
-	return &api.ListCronWorkflowsResponse{
+	resp := &api.ListCronWorkflowsResponse{
 		Count:         int32(len(apiCronWorkflows)),
 		CronWorkflows: apiCronWorkflows,
 		Page:          int32(paginator.Page),
 		Pages:         paginator.CalculatePages(count),
 		TotalCount:    int32(count),
-	}, nil
+	}
+	if len(cronWorkflows) > 0 {
+		last := cronWorkflows[len(cronWorkflows)-1]
+		resp.NextPageToken = paginator.NextToken(len(cronWorkflows), last.CreatedAt, last.ID)
+	}
+
+	return resp, nil
 }
 
 func (c *CronWorkflowServer) DeleteCronWorkflow(ctx context.Context, req *api.DeleteCronWorkflowRequest) (*empty.Empty, error) {
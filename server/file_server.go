@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"github.com/onepanelio/core/api"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/server/auth"
+	"time"
+)
+
+// FileServer is an implementation of the grpc FileServer
+type FileServer struct{}
+
+// NewFileServer creates a new FileServer
+func NewFileServer() *FileServer {
+	return &FileServer{}
+}
+
+func apiFile(file *v1.File) *api.File {
+	result := &api.File{
+		Path:         file.Path,
+		Name:         file.Name,
+		Extension:    file.Extension,
+		Directory:    file.Directory,
+		Size:         file.Size,
+		ContentType:  file.ContentType,
+		LastModified: file.LastModified.UTC().Format(time.RFC3339),
+		Url:          file.URL,
+	}
+	if file.URLExpiresAt != nil {
+		result.UrlExpiresAt = file.URLExpiresAt.Format(time.RFC3339)
+	}
+
+	return result
+}
+
+// apiFileOperationResponse reports the paths affected by an operation.
+// If it stopped partway, the error is reported along with the paths affected before it stopped.
+func apiFileOperationResponse(paths []string, err error) (*api.FileOperationResponse, error) {
+	if err != nil && len(paths) == 0 {
+		return nil, err
+	}
+
+	result := &api.FileOperationResponse{
+		Count: int32(len(paths)),
+		Paths: paths,
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	return result, nil
+}
+
+// DeleteFile deletes a file, or a directory if recursive and confirm are set
+func (s *FileServer) DeleteFile(ctx context.Context, req *api.DeleteFileRequest) (*api.FileOperationResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "delete", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	return apiFileOperationResponse(client.DeleteFiles(req.Namespace, req.Path, req.Recursive, req.Confirm))
+}
+
+// MoveFile moves or renames a file or directory
+func (s *FileServer) MoveFile(ctx context.Context, req *api.FileTransferRequest) (*api.FileOperationResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	return apiFileOperationResponse(client.MoveFiles(req.Namespace, req.Source, req.Destination))
+}
+
+// CopyFile copies a file or directory
+func (s *FileServer) CopyFile(ctx context.Context, req *api.FileTransferRequest) (*api.FileOperationResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "create", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	return apiFileOperationResponse(client.CopyFiles(req.Namespace, req.Source, req.Destination))
+}
+
+// CreateDirectory creates an empty directory
+func (s *FileServer) CreateDirectory(ctx context.Context, req *api.CreateDirectoryRequest) (*api.File, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "create", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	directory, err := client.CreateDirectory(req.Namespace, req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiFile(directory), nil
+}
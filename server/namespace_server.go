@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"math"
+	"sort"
 	"strings"
 
 	"github.com/onepanelio/core/api"
@@ -87,3 +88,52 @@ func (s *NamespaceServer) CreateNamespace(ctx context.Context, createNamespace *
 		Name: namespace.Name,
 	}, nil
 }
+
+// GetQuotaUsage returns the quota of the namespace and how much of it is used
+func (s *NamespaceServer) GetQuotaUsage(ctx context.Context, req *api.GetQuotaUsageRequest) (*api.GetQuotaUsageResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "onepanel.io", "workspaces", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	quota, usage, err := client.GetQuotaUsage(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if quota == nil {
+		quota = &v1.NamespaceQuota{}
+	}
+
+	res := &api.GetQuotaUsageResponse{
+		Workspaces: &api.QuotaUsage{
+			Limit: quota.Workspaces,
+			Used:  usage.Workspaces,
+		},
+		WorkflowExecutions: &api.QuotaUsage{
+			Limit: quota.WorkflowExecutions,
+			Used:  usage.WorkflowExecutions,
+		},
+		NodePools: make([]*api.NodePoolQuotaUsage, 0),
+	}
+
+	nodePools := make(map[string]bool)
+	for nodePool := range quota.NodePools {
+		nodePools[nodePool] = true
+	}
+	for nodePool := range usage.NodePools {
+		nodePools[nodePool] = true
+	}
+	for nodePool := range nodePools {
+		res.NodePools = append(res.NodePools, &api.NodePoolQuotaUsage{
+			NodePool: nodePool,
+			Limit:    quota.NodePools[nodePool],
+			Used:     usage.NodePools[nodePool],
+		})
+	}
+	sort.Slice(res.NodePools, func(i, j int) bool {
+		return res.NodePools[i].NodePool < res.NodePools[j].NodePool
+	})
+
+	return res, nil
+}
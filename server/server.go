@@ -3,7 +3,10 @@ package server
 import (
 	"context"
 	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/pagination"
 	"github.com/onepanelio/core/server/auth"
+	"google.golang.org/grpc/codes"
 )
 
 const (
@@ -13,3 +16,13 @@ const (
 func getClient(ctx context.Context) *v1.Client {
 	return ctx.Value(auth.ContextClientKey).(*v1.Client)
 }
+
+// newPaginator creates a pagination request from the page, pageSize and pageToken fields of a list request
+func newPaginator(page, pageSize int32, pageToken string) (*pagination.PaginationRequest, error) {
+	paginator, err := pagination.NewRequestFromToken(page, pageSize, pageToken)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Invalid page token.")
+	}
+
+	return &paginator, nil
+}
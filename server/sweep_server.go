@@ -0,0 +1,221 @@
+package server
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/onepanelio/core/api"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/server/auth"
+	"github.com/onepanelio/core/server/converter"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+// SweepServer is an implementation of the grpc SweepServer
+type SweepServer struct{}
+
+// NewSweepServer creates a new SweepServer
+func NewSweepServer() *SweepServer {
+	return &SweepServer{}
+}
+
+func apiSweep(sweep *v1.Sweep) *api.Sweep {
+	res := &api.Sweep{
+		Uid:                 sweep.UID,
+		Name:                sweep.Name,
+		Phase:               string(sweep.Phase),
+		Strategy:            string(sweep.Strategy),
+		Parameters:          converter.ParametersToAPI(sweep.Parameters),
+		MaxParallelism:      int32(sweep.MaxParallelism),
+		TotalExecutions:     int32(len(sweep.Trials)),
+		LaunchedExecutions:  int32(sweep.LaunchedExecutions),
+		CompletedExecutions: int32(sweep.CompletedExecutions),
+		Labels:              converter.MappingToKeyValue(sweep.Labels),
+		CreatedAt:           sweep.CreatedAt.UTC().Format(time.RFC3339),
+	}
+
+	if sweep.Strategy == v1.SweepStrategyRandom {
+		res.MaxExecutions = int32(len(sweep.Trials))
+	}
+
+	if sweep.WorkflowTemplate != nil {
+		res.WorkflowTemplateUid = sweep.WorkflowTemplate.UID
+		res.WorkflowTemplateVersion = sweep.WorkflowTemplate.Version
+	}
+
+	for _, parameter := range sweep.SweepParameters {
+		res.SweepParameters = append(res.SweepParameters, &api.SweepParameter{
+			Name:    parameter.Name,
+			Values:  parameter.Values,
+			Min:     parameter.Min,
+			Max:     parameter.Max,
+			Integer: parameter.Integer,
+		})
+	}
+
+	if sweep.ObjectiveMetric != "" {
+		res.Objective = &api.SweepObjective{
+			Metric: sweep.ObjectiveMetric,
+			Goal:   string(sweep.ObjectiveGoal),
+		}
+		if sweep.EarlyStopThreshold != nil {
+			res.Objective.EarlyStop = true
+			res.Objective.EarlyStopThreshold = *sweep.EarlyStopThreshold
+		}
+	}
+
+	for _, failure := range sweep.FailedTrials {
+		res.FailedTrials = append(res.FailedTrials, &api.SweepTrialFailure{
+			Trial: int32(failure.Trial),
+			Error: failure.Error,
+		})
+	}
+
+	if sweep.BestWorkflowExecutionUID != nil {
+		res.BestWorkflowExecutionUid = *sweep.BestWorkflowExecutionUID
+	}
+	if sweep.BestMetricValue != nil {
+		res.BestMetricValue = *sweep.BestMetricValue
+	}
+
+	if sweep.FinishedAt != nil {
+		res.FinishedAt = sweep.FinishedAt.UTC().Format(time.RFC3339)
+	}
+
+	return res
+}
+
+// CreateSweep creates a sweep and starts its first executions
+func (s *SweepServer) CreateSweep(ctx context.Context, req *api.CreateSweepRequest) (*api.Sweep, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "create", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if req.Sweep == nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Sweep is required.")
+	}
+
+	sweep := &v1.Sweep{
+		Name:     req.Sweep.Name,
+		Strategy: v1.SweepStrategy(req.Sweep.Strategy),
+		WorkflowTemplate: &v1.WorkflowTemplate{
+			UID:     req.Sweep.WorkflowTemplateUid,
+			Version: req.Sweep.WorkflowTemplateVersion,
+		},
+		MaxExecutions:  int(req.Sweep.MaxExecutions),
+		MaxParallelism: int(req.Sweep.MaxParallelism),
+		Labels:         converter.APIKeyValueToLabel(req.Sweep.Labels),
+	}
+
+	for _, param := range req.Sweep.Parameters {
+		sweep.Parameters = append(sweep.Parameters, *converter.APIParameterToInternal(param))
+	}
+
+	for _, param := range req.Sweep.SweepParameters {
+		sweep.SweepParameters = append(sweep.SweepParameters, &v1.SweepParameter{
+			Name:    param.Name,
+			Values:  param.Values,
+			Min:     param.Min,
+			Max:     param.Max,
+			Integer: param.Integer,
+		})
+	}
+
+	if req.Sweep.Objective != nil {
+		sweep.ObjectiveMetric = req.Sweep.Objective.Metric
+		sweep.ObjectiveGoal = v1.SweepObjectiveGoal(req.Sweep.Objective.Goal)
+		if req.Sweep.Objective.EarlyStop {
+			threshold := req.Sweep.Objective.EarlyStopThreshold
+			sweep.EarlyStopThreshold = &threshold
+		}
+	}
+
+	sweep, err = client.CreateSweep(req.Namespace, sweep)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiSweep(sweep), nil
+}
+
+// GetSweep returns a sweep along with the progress of its executions
+func (s *SweepServer) GetSweep(ctx context.Context, req *api.GetSweepRequest) (*api.Sweep, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	sweep, err := client.GetSweep(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiSweep(sweep), nil
+}
+
+// ListSweeps returns the sweeps in a namespace, most recently created first
+func (s *SweepServer) ListSweeps(ctx context.Context, req *api.ListSweepsRequest) (*api.ListSweepsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	labelFilter, err := v1.LabelsFromString(req.Labels)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	paginator, err := newPaginator(req.Page, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	sweeps, err := client.ListSweeps(req.Namespace, paginator, labelFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	apiSweeps := make([]*api.Sweep, 0, len(sweeps))
+	for _, sweep := range sweeps {
+		apiSweeps = append(apiSweeps, apiSweep(sweep))
+	}
+
+	count, err := client.CountSweeps(req.Namespace, labelFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.ListSweepsResponse{
+		Count:      int32(len(apiSweeps)),
+		Sweeps:     apiSweeps,
+		Page:       int32(paginator.Page),
+		Pages:      paginator.CalculatePages(count),
+		TotalCount: int32(count),
+	}
+	if len(sweeps) > 0 {
+		last := sweeps[len(sweeps)-1]
+		resp.NextPageToken = paginator.NextToken(len(sweeps), last.CreatedAt, last.ID)
+	}
+
+	return resp, nil
+}
+
+// TerminateSweep stops a running sweep and terminates its running executions
+func (s *SweepServer) TerminateSweep(ctx context.Context, req *api.TerminateSweepRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if err := client.TerminateSweep(req.Namespace, req.Uid); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -1,7 +1,9 @@
 package server
 
 import (
+	"bufio"
 	"context"
+	"fmt"
 	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/onepanelio/core/pkg/util"
@@ -9,7 +11,9 @@ import (
 	"github.com/onepanelio/core/pkg/util/router"
 	"github.com/onepanelio/core/server/converter"
 	"google.golang.org/grpc/codes"
+	"io"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"net/http"
 	"sort"
 	"strings"
 	"time"
@@ -251,6 +255,102 @@ func (s *WorkflowServer) GetWorkflowExecutionLogs(req *api.GetWorkflowExecutionL
 	return nil
 }
 
+// logFilterFromRequest converts the filter fields of a log search request to a LogFilter
+func logFilterFromRequest(req *api.SearchWorkflowExecutionLogsRequest) (*v1.LogFilter, error) {
+	since, err := parseOptionalTime("since", req.Since)
+	if err != nil {
+		return nil, err
+	}
+	until, err := parseOptionalTime("until", req.Until)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := v1.NewLogFilter(req.Query, req.Regex, since, until, int(req.Limit))
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	return filter, nil
+}
+
+func (s *WorkflowServer) SearchWorkflowExecutionLogs(req *api.SearchWorkflowExecutionLogsRequest, stream api.WorkflowService_SearchWorkflowExecutionLogsServer) error {
+	client := getClient(stream.Context())
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return err
+	}
+
+	filter, err := logFilterFromRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return client.SearchWorkflowExecutionLogs(req.Namespace, req.Uid, req.PodName, req.ContainerName, filter, func(entry *v1.LogEntry) error {
+		return stream.Send(&api.LogEntry{
+			Timestamp: entry.Timestamp.String(),
+			Content:   entry.Content,
+		})
+	})
+}
+
+// GetWorkflowExecutionAggregatedLogs streams the logs of the execution's pods merged in timestamp order, until the workflow has finished
+func (s *WorkflowServer) GetWorkflowExecutionAggregatedLogs(req *api.GetWorkflowExecutionAggregatedLogsRequest, stream api.WorkflowService_GetWorkflowExecutionAggregatedLogsServer) error {
+	client := getClient(stream.Context())
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return err
+	}
+
+	containerName := req.ContainerName
+	if containerName == "" {
+		containerName = "main"
+	}
+
+	return client.AggregateWorkflowExecutionLogs(req.Namespace, req.Uid, req.Nodes, containerName, stream.Context().Done(), func(entry *v1.LogEntry) error {
+		return stream.Send(&api.LogEntry{
+			Timestamp:     entry.Timestamp.String(),
+			Content:       entry.Content,
+			NodeName:      entry.NodeName,
+			PodName:       entry.PodName,
+			ContainerName: entry.ContainerName,
+		})
+	})
+}
+
+// DownloadWorkflowExecutionLogs streams the log in chunks of artifactChunkSize bytes.
+// The first chunk always carries the content type, even if no lines are selected.
+func (s *WorkflowServer) DownloadWorkflowExecutionLogs(req *api.SearchWorkflowExecutionLogsRequest, stream api.WorkflowService_DownloadWorkflowExecutionLogsServer) error {
+	client := getClient(stream.Context())
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return err
+	}
+
+	filter, err := logFilterFromRequest(req)
+	if err != nil {
+		return err
+	}
+
+	chunkWriter := &artifactChunkWriter{
+		stream:      stream,
+		contentType: "text/plain; charset=utf-8",
+	}
+	writer := bufio.NewWriterSize(chunkWriter, artifactChunkSize)
+	if err := client.WriteWorkflowExecutionLogs(req.Namespace, req.Uid, req.PodName, req.ContainerName, filter, writer); err != nil {
+		return err
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	if chunkWriter.offset == 0 {
+		return stream.Send(&api.ArtifactChunk{ContentType: chunkWriter.contentType})
+	}
+
+	return nil
+}
+
 func (s *WorkflowServer) GetWorkflowExecutionMetrics(ctx context.Context, req *api.GetWorkflowExecutionMetricsRequest) (*api.GetWorkflowExecutionMetricsResponse, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
@@ -278,6 +378,118 @@ func (s *WorkflowServer) GetWorkflowExecutionMetrics(ctx context.Context, req *a
 	return &api.GetWorkflowExecutionMetricsResponse{Metrics: apiMetrics}, nil
 }
 
+func (s *WorkflowServer) AddWorkflowExecutionMetrics(ctx context.Context, req *api.AddWorkflowExecutionMetricsRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if req.Body == nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "At least one metric is required.")
+	}
+
+	metrics := make([]*v1.WorkflowExecutionMetric, 0, len(req.Body.Metrics))
+	for _, m := range req.Body.Metrics {
+		metric := &v1.WorkflowExecutionMetric{
+			Name:   m.Name,
+			Value:  m.Value,
+			Format: m.Format,
+			Step:   m.Step,
+		}
+		timestamp, err := parseOptionalTime("timestamp", m.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		if timestamp != nil {
+			metric.Timestamp = *timestamp
+		}
+		metrics = append(metrics, metric)
+	}
+
+	if err := client.AddWorkflowExecutionMetrics(req.Namespace, req.Uid, req.PodName, metrics); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *WorkflowServer) WatchWorkflowExecutionMetrics(req *api.WatchWorkflowExecutionMetricsRequest, stream api.WorkflowService_WatchWorkflowExecutionMetricsServer) error {
+	client := getClient(stream.Context())
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return err
+	}
+
+	watcher, errs, err := client.WatchWorkflowExecutionMetrics(stream.Context(), req.Namespace, req.Uid, req.PodName)
+	if err != nil {
+		return err
+	}
+
+	for metric := range watcher {
+		if err := stream.Send(&api.MetricPoint{
+			Name:      metric.Name,
+			Value:     metric.Value,
+			Format:    metric.Format,
+			Step:      metric.Step,
+			Timestamp: metric.Timestamp.UTC().Format(time.RFC3339Nano),
+			PodName:   metric.PodName,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return <-errs
+}
+
+// parseOptionalTime parses an RFC3339 time, returning nil if the value is empty.
+func parseOptionalTime(name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	result, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("%v must be an RFC3339 time.", name))
+	}
+
+	return &result, nil
+}
+
+// workflowExecutionFilterFromRequest converts the filter fields of a list request to a WorkflowExecutionFilter
+func workflowExecutionFilterFromRequest(req *api.ListWorkflowExecutionsRequest) (filter *v1.WorkflowExecutionFilter, err error) {
+	labelFilter, err := v1.LabelsFromString(req.Labels)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	filter = &v1.WorkflowExecutionFilter{
+		Labels: labelFilter,
+		Name:   req.Name,
+	}
+	for _, phase := range req.Phases {
+		filter.Phases = append(filter.Phases, wfv1.NodePhase(phase))
+	}
+	if req.Archived {
+		filter.Archived = ptr.Bool(true)
+	}
+
+	if filter.StartedAfter, err = parseOptionalTime("startedAfter", req.StartedAfter); err != nil {
+		return nil, err
+	}
+	if filter.StartedBefore, err = parseOptionalTime("startedBefore", req.StartedBefore); err != nil {
+		return nil, err
+	}
+	if filter.FinishedAfter, err = parseOptionalTime("finishedAfter", req.FinishedAfter); err != nil {
+		return nil, err
+	}
+	if filter.FinishedBefore, err = parseOptionalTime("finishedBefore", req.FinishedBefore); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
 func (s *WorkflowServer) ListWorkflowExecutions(ctx context.Context, req *api.ListWorkflowExecutionsRequest) (*api.ListWorkflowExecutionsResponse, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "argoproj.io", "workflows", "")
@@ -285,8 +497,21 @@ func (s *WorkflowServer) ListWorkflowExecutions(ctx context.Context, req *api.Li
 		return nil, err
 	}
 
-	paginator := pagination.NewRequest(req.Page, req.PageSize)
-	workflows, err := client.ListWorkflowExecutions(req.Namespace, req.WorkflowTemplateUid, req.WorkflowTemplateVersion, &paginator)
+	filter, err := workflowExecutionFilterFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	sort, err := v1.WorkflowExecutionSortFromString(req.Order)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	paginator, err := newPaginator(req.Page, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	workflows, err := client.ListWorkflowExecutions(req.Namespace, req.WorkflowTemplateUid, req.WorkflowTemplateVersion, paginator, filter, sort)
 	if err != nil {
 		return nil, err
 	}
@@ -302,17 +527,89 @@ func (s *WorkflowServer) ListWorkflowExecutions(ctx context.Context, req *api.Li
 		apiWorkflowExecutions = append(apiWorkflowExecutions, apiWorkflowExecution(wf, webRouter))
 	}
 
-	count, err := client.CountWorkflowExecutions(req.Namespace, req.WorkflowTemplateUid, req.WorkflowTemplateVersion)
+	count, err := client.CountWorkflowExecutions(req.Namespace, req.WorkflowTemplateUid, req.WorkflowTemplateVersion, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	return &api.ListWorkflowExecutionsResponse{
+	resp := &api.ListWorkflowExecutionsResponse{
 		Count:              int32(len(apiWorkflowExecutions)),
 		WorkflowExecutions: apiWorkflowExecutions,
 		Page:               int32(paginator.Page),
 		Pages:              paginator.CalculatePages(count),
 		TotalCount:         int32(count),
+	}
+	if len(workflows) > 0 && sort.SupportsPageToken() {
+		last := workflows[len(workflows)-1]
+		resp.NextPageToken = paginator.NextToken(len(workflows), last.CreatedAt, last.ID)
+	}
+
+	return resp, nil
+}
+
+func (s *WorkflowServer) CompareWorkflowExecutions(ctx context.Context, req *api.CompareWorkflowExecutionsRequest) (*api.CompareWorkflowExecutionsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	labelFilter, err := v1.LabelsFromString(req.Labels)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+	filter := &v1.WorkflowExecutionFilter{
+		Labels: labelFilter,
+	}
+	for _, phase := range req.Phases {
+		filter.Phases = append(filter.Phases, wfv1.NodePhase(phase))
+	}
+
+	paginator := pagination.NewRequest(req.Page, req.PageSize)
+	comparisons, count, err := client.CompareWorkflowExecutions(req.Namespace, req.WorkflowTemplateUid, req.WorkflowTemplateVersion, &paginator, filter, v1.WorkflowExecutionMetricSortFromString(req.Order))
+	if err != nil {
+		return nil, err
+	}
+
+	webRouter, err := client.GetWebRouter()
+	if err != nil {
+		return nil, err
+	}
+
+	metricNames := make([]string, 0)
+	seenMetricNames := make(map[string]bool)
+	for _, comparison := range comparisons {
+		for _, metric := range comparison.Metrics {
+			if !seenMetricNames[metric.Name] {
+				seenMetricNames[metric.Name] = true
+				metricNames = append(metricNames, metric.Name)
+			}
+		}
+	}
+	sort.Strings(metricNames)
+
+	apiComparisons := make([]*api.WorkflowExecutionComparison, 0)
+	for _, comparison := range comparisons {
+		apiComparison := &api.WorkflowExecutionComparison{
+			WorkflowExecution: apiWorkflowExecution(comparison.WorkflowExecution, webRouter),
+		}
+		for _, m := range comparison.Metrics {
+			apiComparison.Metrics = append(apiComparison.Metrics, &api.Metric{
+				Name:   m.Name,
+				Value:  m.Value,
+				Format: m.Format,
+			})
+		}
+		apiComparisons = append(apiComparisons, apiComparison)
+	}
+
+	return &api.CompareWorkflowExecutionsResponse{
+		Count:              int32(len(apiComparisons)),
+		WorkflowExecutions: apiComparisons,
+		Page:               int32(paginator.Page),
+		Pages:              paginator.CalculatePages(count),
+		TotalCount:         int32(count),
+		MetricNames:        metricNames,
 	}, nil
 }
 
@@ -352,6 +649,79 @@ func (s *WorkflowServer) TerminateWorkflowExecution(ctx context.Context, req *ap
 	return &empty.Empty{}, nil
 }
 
+// batchWorkflowExecutions resolves the executions selected by the request and applies the action to each of them
+func (s *WorkflowServer) batchWorkflowExecutions(ctx context.Context, req *api.BatchWorkflowExecutionsRequest, verb string, action v1.WorkflowExecutionBatchAction) (*api.BatchWorkflowExecutionsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, verb, "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if req.Body == nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Body is required.")
+	}
+
+	uids := req.Body.Uids
+	if len(uids) == 0 {
+		// Acting on every execution in the namespace is almost certainly a mistake
+		if req.Body.WorkflowTemplateUid == "" && req.Body.Labels == "" && len(req.Body.Phases) == 0 {
+			return nil, util.NewUserError(codes.InvalidArgument, "Either uids or a filter is required.")
+		}
+
+		labelFilter, err := v1.LabelsFromString(req.Body.Labels)
+		if err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+		}
+		filter := &v1.WorkflowExecutionFilter{
+			Labels: labelFilter,
+		}
+		for _, phase := range req.Body.Phases {
+			filter.Phases = append(filter.Phases, wfv1.NodePhase(phase))
+		}
+
+		uids, err = client.ListWorkflowExecutionUIDs(req.Namespace, req.Body.WorkflowTemplateUid, filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	results, err := client.BatchWorkflowExecutions(req.Namespace, action, uids)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.BatchWorkflowExecutionsResponse{
+		Results: make([]*api.BatchWorkflowExecutionResult, len(results)),
+	}
+	for i, result := range results {
+		apiResult := &api.BatchWorkflowExecutionResult{
+			Uid:     result.UID,
+			Success: result.Error == nil,
+		}
+		if result.Error != nil {
+			apiResult.Error = result.Error.Error()
+			resp.Failed++
+		} else {
+			resp.Succeeded++
+		}
+		resp.Results[i] = apiResult
+	}
+
+	return resp, nil
+}
+
+func (s *WorkflowServer) TerminateWorkflowExecutions(ctx context.Context, req *api.BatchWorkflowExecutionsRequest) (*api.BatchWorkflowExecutionsResponse, error) {
+	return s.batchWorkflowExecutions(ctx, req, "update", v1.WorkflowExecutionBatchTerminate)
+}
+
+func (s *WorkflowServer) ArchiveWorkflowExecutions(ctx context.Context, req *api.BatchWorkflowExecutionsRequest) (*api.BatchWorkflowExecutionsResponse, error) {
+	return s.batchWorkflowExecutions(ctx, req, "delete", v1.WorkflowExecutionBatchArchive)
+}
+
+func (s *WorkflowServer) RetryWorkflowExecutions(ctx context.Context, req *api.BatchWorkflowExecutionsRequest) (*api.BatchWorkflowExecutionsResponse, error) {
+	return s.batchWorkflowExecutions(ctx, req, "update", v1.WorkflowExecutionBatchRetry)
+}
+
 func (s *WorkflowServer) GetArtifact(ctx context.Context, req *api.GetArtifactRequest) (*api.ArtifactResponse, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
@@ -359,7 +729,7 @@ func (s *WorkflowServer) GetArtifact(ctx context.Context, req *api.GetArtifactRe
 		return nil, err
 	}
 
-	data, err := client.GetArtifact(req.Namespace, req.Uid, req.Key)
+	data, err := client.GetArtifact(req.Namespace, req.Uid, req.Repository, req.Key)
 	if err != nil {
 		return nil, err
 	}
@@ -369,6 +739,150 @@ func (s *WorkflowServer) GetArtifact(ctx context.Context, req *api.GetArtifactRe
 	}, nil
 }
 
+// DownloadArtifact streams an artifact in chunks of artifactChunkSize bytes.
+// The first chunk always carries the size and content type, even if the artifact is empty.
+func (s *WorkflowServer) DownloadArtifact(req *api.DownloadArtifactRequest, stream api.WorkflowService_DownloadArtifactServer) error {
+	client := getClient(stream.Context())
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return err
+	}
+
+	artifact, err := client.GetArtifactStream(req.Namespace, req.Repository, req.Key, req.Offset, req.Length)
+	if err != nil {
+		return err
+	}
+	defer artifact.Reader.Close()
+
+	chunk := &api.ArtifactChunk{
+		Offset:      artifact.Offset,
+		TotalSize:   artifact.Size,
+		Length:      artifact.Length,
+		ContentType: artifact.ContentType,
+	}
+	sent := false
+	offset := artifact.Offset
+	buffer := make([]byte, artifactChunkSize)
+	for {
+		n, err := io.ReadFull(artifact.Reader, buffer)
+		if n > 0 {
+			chunk.Data = buffer[:n]
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+			sent = true
+			offset += int64(n)
+			chunk = &api.ArtifactChunk{Offset: offset}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if !sent {
+		return stream.Send(chunk)
+	}
+
+	return nil
+}
+
+// artifactChunkSender is a stream that ArtifactChunks are sent to
+type artifactChunkSender interface {
+	Send(*api.ArtifactChunk) error
+}
+
+// artifactChunkWriter sends everything written to it as ArtifactChunks.
+// The first chunk carries contentType.
+type artifactChunkWriter struct {
+	stream      artifactChunkSender
+	contentType string
+	offset      int64
+}
+
+func (w *artifactChunkWriter) Write(p []byte) (int, error) {
+	chunk := &api.ArtifactChunk{
+		Data:   p,
+		Offset: w.offset,
+	}
+	if w.offset == 0 {
+		chunk.ContentType = w.contentType
+	}
+
+	if err := w.stream.Send(chunk); err != nil {
+		return 0, err
+	}
+	w.offset += int64(len(p))
+
+	return len(p), nil
+}
+
+// DownloadArtifactArchive streams a zip or tar.gz archive of a directory in chunks of artifactChunkSize bytes.
+// The size of the archive is not known in advance, so chunks do not have a totalSize.
+func (s *WorkflowServer) DownloadArtifactArchive(req *api.DownloadArtifactArchiveRequest, stream api.WorkflowService_DownloadArtifactArchiveServer) error {
+	client := getClient(stream.Context())
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return err
+	}
+
+	format, err := v1.ArtifactArchiveFormatFromString(req.Format)
+	if err != nil {
+		return util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	writer := bufio.NewWriterSize(&artifactChunkWriter{
+		stream:      stream,
+		contentType: format.ContentType(),
+	}, artifactChunkSize)
+	if err := client.WriteArtifactArchive(req.Namespace, req.Prefix, format, writer); err != nil {
+		return err
+	}
+
+	return writer.Flush()
+}
+
+func apiArtifactURL(artifactURL *v1.ArtifactURL) *api.ArtifactURL {
+	return &api.ArtifactURL{
+		Url:       artifactURL.URL,
+		Method:    artifactURL.Method,
+		ExpiresAt: artifactURL.ExpiresAt.Format(time.RFC3339),
+	}
+}
+
+func (s *WorkflowServer) GetArtifactURL(ctx context.Context, req *api.GetArtifactURLRequest) (*api.ArtifactURL, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	artifactURL, err := client.PresignArtifactURL(req.Namespace, req.Repository, req.Key, http.MethodGet, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return apiArtifactURL(artifactURL), nil
+}
+
+// CreateArtifactUploadURL returns a presigned URL to upload an input file for workflows, so it requires permission to create workflows
+func (s *WorkflowServer) CreateArtifactUploadURL(ctx context.Context, req *api.CreateArtifactUploadURLRequest) (*api.ArtifactURL, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "create", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	artifactURL, err := client.PresignArtifactURL(req.Namespace, req.Repository, req.Key, http.MethodPut, req.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiArtifactURL(artifactURL), nil
+}
+
 func (s *WorkflowServer) ListFiles(ctx context.Context, req *api.ListFilesRequest) (*api.ListFilesResponse, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
@@ -376,22 +890,14 @@ func (s *WorkflowServer) ListFiles(ctx context.Context, req *api.ListFilesReques
 		return nil, err
 	}
 
-	files, err := client.ListFiles(req.Namespace, req.Path)
+	files, err := client.ListFiles(req.Namespace, req.Repository, req.Path)
 	if err != nil {
 		return nil, err
 	}
 
 	apiFiles := make([]*api.File, len(files))
 	for i, file := range files {
-		apiFiles[i] = &api.File{
-			Path:         file.Path,
-			Name:         file.Name,
-			Extension:    file.Extension,
-			Directory:    file.Directory,
-			Size:         file.Size,
-			ContentType:  file.ContentType,
-			LastModified: file.LastModified.UTC().Format(time.RFC3339),
-		}
+		apiFiles[i] = apiFile(file)
 	}
 
 	sort.Slice(apiFiles, func(i, j int) bool {
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"github.com/onepanelio/core/api"
 	v1 "github.com/onepanelio/core/pkg"
-	"github.com/onepanelio/core/pkg/util/pagination"
 	"github.com/onepanelio/core/server/auth"
 	"github.com/onepanelio/core/server/converter"
 	"time"
@@ -196,8 +195,11 @@ func (s *WorkflowTemplateServer) ListWorkflowTemplates(ctx context.Context, req
 		Labels: labelFilter,
 	}
 
-	paginator := pagination.NewRequest(req.Page, req.PageSize)
-	workflowTemplates, err := client.ListWorkflowTemplates(req.Namespace, &paginator, &filter)
+	paginator, err := newPaginator(req.Page, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	workflowTemplates, err := client.ListWorkflowTemplates(req.Namespace, paginator, &filter)
 	if err != nil {
 		return nil, err
 	}
@@ -212,13 +214,19 @@ func (s *WorkflowTemplateServer) ListWorkflowTemplates(ctx context.Context, req
 		return nil, err
 	}
 
-	return &api.ListWorkflowTemplatesResponse{
+	resp := &api.ListWorkflowTemplatesResponse{
 		Count:             int32(len(apiWorkflowTemplates)),
 		WorkflowTemplates: apiWorkflowTemplates,
 		Page:              int32(paginator.Page),
 		Pages:             paginator.CalculatePages(count),
 		TotalCount:        int32(count),
-	}, nil
+	}
+	if len(workflowTemplates) > 0 {
+		last := workflowTemplates[len(workflowTemplates)-1]
+		resp.NextPageToken = paginator.NextToken(len(workflowTemplates), last.CreatedAt, last.ID)
+	}
+
+	return resp, nil
 }
 
 func (s *WorkflowTemplateServer) ArchiveWorkflowTemplate(ctx context.Context, req *api.ArchiveWorkflowTemplateRequest) (*api.ArchiveWorkflowTemplateResponse, error) {
@@ -2,16 +2,17 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/onepanelio/core/api"
 	v1 "github.com/onepanelio/core/pkg"
 	"github.com/onepanelio/core/pkg/util"
-	"github.com/onepanelio/core/pkg/util/pagination"
 	"github.com/onepanelio/core/pkg/util/ptr"
 	"github.com/onepanelio/core/server/auth"
 	"github.com/onepanelio/core/server/converter"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
+	"sort"
 	"time"
 )
 
@@ -52,7 +53,8 @@ func apiWorkspace(wt *v1.Workspace, config v1.SystemConfig) *api.Workspace {
 	res.Parameters = converter.ParametersToAPI(wt.Parameters)
 
 	res.Status = &api.WorkspaceStatus{
-		Phase: string(wt.Status.Phase),
+		Phase:  string(wt.Status.Phase),
+		Reason: wt.Status.Reason,
 	}
 
 	if wt.Status.StartedAt != nil {
@@ -67,10 +69,18 @@ func apiWorkspace(wt *v1.Workspace, config v1.SystemConfig) *api.Workspace {
 		res.Status.TerminatedAt = wt.Status.TerminatedAt.UTC().Format(time.RFC3339)
 	}
 
+	if wt.Status.LastActivityAt != nil {
+		res.Status.LastActivityAt = wt.Status.LastActivityAt.UTC().Format(time.RFC3339)
+	}
+
 	if len(wt.Labels) > 0 {
 		res.Labels = converter.MappingToKeyValue(wt.Labels)
 	}
 
+	if wt.Schedule != nil {
+		res.Schedule = apiWorkspaceSchedule(wt.Schedule)
+	}
+
 	if wt.WorkspaceTemplate != nil {
 		res.WorkspaceTemplate = apiWorkspaceTemplate(wt.WorkspaceTemplate)
 	}
@@ -94,7 +104,8 @@ func (s *WorkspaceServer) CreateWorkspace(ctx context.Context, req *api.CreateWo
 			UID:     req.Body.WorkspaceTemplateUid,
 			Version: req.Body.WorkspaceTemplateVersion,
 		},
-		Labels: converter.APIKeyValueToLabel(req.Body.Labels),
+		Labels:   converter.APIKeyValueToLabel(req.Body.Labels),
+		Schedule: workspaceSchedule(req.Body.Schedule),
 	}
 
 	for _, param := range req.Body.Parameters {
@@ -206,6 +217,61 @@ func (s *WorkspaceServer) UpdateWorkspace(ctx context.Context, req *api.UpdateWo
 	return &empty.Empty{}, err
 }
 
+func (s *WorkspaceServer) UpgradeWorkspace(ctx context.Context, req *api.UpgradeWorkspaceRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return &empty.Empty{}, err
+	}
+
+	var parameters []v1.Parameter
+	for _, param := range req.Body.GetParameters() {
+		if param.Type == "input.hidden" {
+			continue
+		}
+
+		parameters = append(parameters, v1.Parameter{
+			Name:  param.Name,
+			Value: ptr.String(param.Value),
+		})
+	}
+	err = client.UpgradeWorkspace(req.Namespace, req.Uid, req.Body.GetVersion(), parameters)
+
+	return &empty.Empty{}, err
+}
+
+func (s *WorkspaceServer) CloneWorkspace(ctx context.Context, req *api.CloneWorkspaceRequest) (*api.Workspace, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+	allowed, err = auth.IsAuthorized(client, req.Namespace, "create", "onepanel.io", "workspaces", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	workspace := &v1.Workspace{
+		Name:   req.Body.GetName(),
+		Labels: converter.APIKeyValueToLabel(req.Body.GetLabels()),
+	}
+	if _, isReserved := reservedWorkspaceNames[workspace.Name]; isReserved {
+		return nil, util.NewUserError(codes.AlreadyExists, "That name is reserved, choose a different name for the workspace.")
+	}
+
+	workspace, err = client.CloneWorkspace(req.Namespace, req.Uid, workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	sysConfig, err := client.GetSystemConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkspace(workspace, sysConfig), nil
+}
+
 func (s *WorkspaceServer) ListWorkspaces(ctx context.Context, req *api.ListWorkspaceRequest) (*api.ListWorkspaceResponse, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "onepanel.io", "workspaces", "")
@@ -213,8 +279,11 @@ func (s *WorkspaceServer) ListWorkspaces(ctx context.Context, req *api.ListWorks
 		return nil, err
 	}
 
-	paginator := pagination.NewRequest(req.Page, req.PageSize)
-	workspaces, err := client.ListWorkspaces(req.Namespace, &paginator)
+	paginator, err := newPaginator(req.Page, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	workspaces, err := client.ListWorkspaces(req.Namespace, paginator)
 	if err != nil {
 		return nil, err
 	}
@@ -233,13 +302,19 @@ func (s *WorkspaceServer) ListWorkspaces(ctx context.Context, req *api.ListWorks
 		return nil, err
 	}
 
-	return &api.ListWorkspaceResponse{
+	resp := &api.ListWorkspaceResponse{
 		Count:      int32(len(apiWorkspaces)),
 		Workspaces: apiWorkspaces,
 		Page:       int32(paginator.Page),
 		Pages:      paginator.CalculatePages(count),
 		TotalCount: int32(count),
-	}, nil
+	}
+	if len(workspaces) > 0 {
+		last := workspaces[len(workspaces)-1]
+		resp.NextPageToken = paginator.NextToken(len(workspaces), last.CreatedAt, last.ID)
+	}
+
+	return resp, nil
 }
 
 func (s *WorkspaceServer) PauseWorkspace(ctx context.Context, req *api.PauseWorkspaceRequest) (*empty.Empty, error) {
@@ -338,3 +413,347 @@ func (s *WorkspaceServer) RetryLastWorkspaceAction(ctx context.Context, req *api
 
 	return &empty.Empty{}, err
 }
+
+// GetWorkspaceLogs streams a container's log from the workspace's pods or its last action workflow
+func (s *WorkspaceServer) GetWorkspaceLogs(req *api.GetWorkspaceLogsRequest, stream api.WorkspaceService_GetWorkspaceLogsServer) error {
+	client := getClient(stream.Context())
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return err
+	}
+	allowed, err = auth.IsAuthorizedSubresource(client, req.Namespace, "get", "", "pods", "log", req.PodName)
+	if err != nil || !allowed {
+		return err
+	}
+	if req.Action {
+		allowed, err = auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", "")
+		if err != nil || !allowed {
+			return err
+		}
+	}
+
+	if req.TailLines < 0 {
+		return util.NewUserError(codes.InvalidArgument, "tailLines can not be negative.")
+	}
+
+	options := &v1.WorkspaceLogOptions{
+		PodName:       req.PodName,
+		ContainerName: req.ContainerName,
+		Follow:        req.Follow,
+		TailLines:     req.TailLines,
+		Previous:      req.Previous,
+		Action:        req.Action,
+	}
+
+	return client.GetWorkspaceLogs(req.Namespace, req.Uid, options, stream.Context().Done(), func(entry *v1.LogEntry) error {
+		return stream.Send(&api.LogEntry{
+			Timestamp:     entry.Timestamp.String(),
+			Content:       entry.Content,
+			NodeName:      entry.NodeName,
+			PodName:       entry.PodName,
+			ContainerName: entry.ContainerName,
+		})
+	})
+}
+
+// apiWorkspaceSchedule converts a workspace schedule to its API representation, with the next scheduled times
+func apiWorkspaceSchedule(schedule *v1.WorkspaceSchedule) *api.WorkspaceSchedule {
+	res := &api.WorkspaceSchedule{
+		Resume:   schedule.Resume,
+		Pause:    schedule.Pause,
+		Timezone: schedule.Timezone,
+	}
+
+	now := time.Now()
+	if next, err := schedule.Next(v1.WorkspaceScheduleResume, now); err == nil && next != nil {
+		res.NextResumeAt = next.Format(time.RFC3339)
+	}
+	if next, err := schedule.Next(v1.WorkspaceSchedulePause, now); err == nil && next != nil {
+		res.NextPauseAt = next.Format(time.RFC3339)
+	}
+
+	return res
+}
+
+// workspaceSchedule converts an API workspace schedule, it returns nil if there is none
+func workspaceSchedule(schedule *api.WorkspaceSchedule) *v1.WorkspaceSchedule {
+	if schedule == nil {
+		return nil
+	}
+
+	return &v1.WorkspaceSchedule{
+		Resume:   schedule.Resume,
+		Pause:    schedule.Pause,
+		Timezone: schedule.Timezone,
+	}
+}
+
+// GetWorkspaceSchedule returns the schedule that resumes and pauses the workspace
+func (s *WorkspaceServer) GetWorkspaceSchedule(ctx context.Context, req *api.GetWorkspaceScheduleRequest) (*api.WorkspaceSchedule, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	workspace, err := client.GetWorkspace(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	if workspace == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+	if workspace.Schedule == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workspace has no schedule.")
+	}
+
+	return apiWorkspaceSchedule(workspace.Schedule), nil
+}
+
+// UpdateWorkspaceSchedule creates or replaces the schedule of the workspace
+func (s *WorkspaceServer) UpdateWorkspaceSchedule(ctx context.Context, req *api.UpdateWorkspaceScheduleRequest) (*api.WorkspaceSchedule, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	schedule := workspaceSchedule(req.Schedule)
+	if err := client.UpdateWorkspaceSchedule(req.Namespace, req.Uid, schedule); err != nil {
+		return nil, err
+	}
+
+	return apiWorkspaceSchedule(schedule), nil
+}
+
+// DeleteWorkspaceSchedule removes the schedule of the workspace
+func (s *WorkspaceServer) DeleteWorkspaceSchedule(ctx context.Context, req *api.DeleteWorkspaceScheduleRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return &empty.Empty{}, err
+	}
+
+	err = client.DeleteWorkspaceSchedule(req.Namespace, req.Uid)
+
+	return &empty.Empty{}, err
+}
+
+// RecordWorkspaceActivity records a heartbeat sent by the workspace's containers.
+// It only requires update on the workspaces/activity subresource, which can be granted to the service account of the workspace's pods
+// without letting them update the workspace.
+func (s *WorkspaceServer) RecordWorkspaceActivity(ctx context.Context, req *api.RecordWorkspaceActivityRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorizedSubresource(client, req.Namespace, "update", "onepanel.io", "workspaces", "activity", req.Uid)
+	if err != nil || !allowed {
+		return &empty.Empty{}, err
+	}
+
+	err = client.RecordWorkspaceActivity(req.Namespace, req.Uid)
+
+	return &empty.Empty{}, err
+}
+
+// apiWorkspaceEvent converts a workspace event to its API representation
+func apiWorkspaceEvent(event *v1.WorkspaceEvent) (*api.WorkspaceEvent, error) {
+	details, err := json.Marshal(event.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.WorkspaceEvent{
+		Type:          string(event.Type),
+		Phase:         string(event.Phase),
+		PreviousPhase: string(event.PreviousPhase),
+		Reason:        event.Reason,
+		Details:       string(details),
+		Actor:         event.Actor,
+		CreatedAt:     event.CreatedAt.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// ListWorkspaceEvents lists the history of the workspace, most recent first
+func (s *WorkspaceServer) ListWorkspaceEvents(ctx context.Context, req *api.ListWorkspaceEventsRequest) (*api.ListWorkspaceEventsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	paginator, err := newPaginator(req.Page, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	eventType := v1.WorkspaceEventType(req.Type)
+	events, err := client.ListWorkspaceEvents(req.Namespace, req.Uid, eventType, paginator)
+	if err != nil {
+		return nil, err
+	}
+
+	apiEvents := make([]*api.WorkspaceEvent, 0, len(events))
+	for _, event := range events {
+		apiEvent, err := apiWorkspaceEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		apiEvents = append(apiEvents, apiEvent)
+	}
+
+	count, err := client.CountWorkspaceEvents(req.Namespace, req.Uid, eventType)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.ListWorkspaceEventsResponse{
+		Count:      int32(len(apiEvents)),
+		Events:     apiEvents,
+		Page:       int32(paginator.Page),
+		Pages:      paginator.CalculatePages(count),
+		TotalCount: int32(count),
+	}
+	if len(events) > 0 {
+		last := events[len(events)-1]
+		resp.NextPageToken = paginator.NextToken(len(events), last.CreatedAt, last.ID)
+	}
+
+	return resp, nil
+}
+
+func apiWorkspaceSnapshot(snapshot *v1.WorkspaceSnapshot) *api.WorkspaceSnapshot {
+	res := &api.WorkspaceSnapshot{
+		Uid:          snapshot.UID,
+		Name:         snapshot.Name,
+		WorkspaceUid: snapshot.WorkspaceUID,
+		Labels:       converter.MappingToKeyValue(snapshot.Labels),
+		Ready:        snapshot.Ready,
+		Error:        snapshot.Error,
+		CreatedAt:    snapshot.CreatedAt.UTC().Format(time.RFC3339),
+	}
+
+	for volumeName := range snapshot.VolumeSnapshots {
+		res.Volumes = append(res.Volumes, volumeName)
+	}
+	sort.Strings(res.Volumes)
+
+	return res
+}
+
+func (s *WorkspaceServer) CreateWorkspaceSnapshot(ctx context.Context, req *api.CreateWorkspaceSnapshotRequest) (*api.WorkspaceSnapshot, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	snapshot, err := client.CreateWorkspaceSnapshot(req.Namespace, req.Uid, &v1.WorkspaceSnapshot{
+		Name:   req.Body.GetName(),
+		Labels: converter.APIKeyValueToLabel(req.Body.GetLabels()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkspaceSnapshot(snapshot), nil
+}
+
+func (s *WorkspaceServer) ListWorkspaceSnapshots(ctx context.Context, req *api.ListWorkspaceSnapshotsRequest) (*api.ListWorkspaceSnapshotsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	labelFilter, err := v1.LabelsFromString(req.Labels)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	paginator, err := newPaginator(req.Page, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := client.ListWorkspaceSnapshots(req.Namespace, req.Uid, paginator, labelFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	apiSnapshots := make([]*api.WorkspaceSnapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		apiSnapshots = append(apiSnapshots, apiWorkspaceSnapshot(snapshot))
+	}
+
+	count, err := client.CountWorkspaceSnapshots(req.Namespace, req.Uid, labelFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.ListWorkspaceSnapshotsResponse{
+		Count:      int32(len(apiSnapshots)),
+		Snapshots:  apiSnapshots,
+		Page:       int32(paginator.Page),
+		Pages:      paginator.CalculatePages(count),
+		TotalCount: int32(count),
+	}
+	if len(snapshots) > 0 {
+		last := snapshots[len(snapshots)-1]
+		resp.NextPageToken = paginator.NextToken(len(snapshots), last.CreatedAt, last.ID)
+	}
+
+	return resp, nil
+}
+
+func (s *WorkspaceServer) GetWorkspaceSnapshot(ctx context.Context, req *api.GetWorkspaceSnapshotRequest) (*api.WorkspaceSnapshot, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	snapshot, err := client.GetWorkspaceSnapshot(req.Namespace, req.Uid, req.SnapshotUid)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, util.NewUserError(codes.NotFound, "Snapshot not found.")
+	}
+
+	return apiWorkspaceSnapshot(snapshot), nil
+}
+
+func (s *WorkspaceServer) DeleteWorkspaceSnapshot(ctx context.Context, req *api.GetWorkspaceSnapshotRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return &empty.Empty{}, err
+	}
+
+	err = client.DeleteWorkspaceSnapshot(req.Namespace, req.Uid, req.SnapshotUid)
+
+	return &empty.Empty{}, err
+}
+
+func (s *WorkspaceServer) RestoreWorkspaceSnapshot(ctx context.Context, req *api.GetWorkspaceSnapshotRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return &empty.Empty{}, err
+	}
+
+	err = client.RestoreWorkspaceSnapshot(req.Namespace, req.Uid, req.SnapshotUid)
+
+	return &empty.Empty{}, err
+}
+
+func (s *WorkspaceServer) ResizeWorkspaceVolume(ctx context.Context, req *api.ResizeWorkspaceVolumeRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return &empty.Empty{}, err
+	}
+
+	err = client.ResizeWorkspaceVolume(req.Namespace, req.Uid, req.VolumeName, req.Size)
+
+	return &empty.Empty{}, err
+}
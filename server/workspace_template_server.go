@@ -5,7 +5,6 @@ import (
 	"github.com/onepanelio/core/api"
 	v1 "github.com/onepanelio/core/pkg"
 	"github.com/onepanelio/core/pkg/util"
-	"github.com/onepanelio/core/pkg/util/pagination"
 	"github.com/onepanelio/core/server/auth"
 	"github.com/onepanelio/core/server/converter"
 	"google.golang.org/grpc/codes"
@@ -135,8 +134,11 @@ func (s *WorkspaceTemplateServer) ListWorkspaceTemplates(ctx context.Context, re
 		return nil, err
 	}
 
-	paginator := pagination.NewRequest(req.Page, req.PageSize)
-	workspaceTemplates, err := client.ListWorkspaceTemplates(req.Namespace, &paginator)
+	paginator, err := newPaginator(req.Page, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	workspaceTemplates, err := client.ListWorkspaceTemplates(req.Namespace, paginator)
 	if err != nil {
 		return nil, err
 	}
@@ -151,13 +153,19 @@ func (s *WorkspaceTemplateServer) ListWorkspaceTemplates(ctx context.Context, re
 		return nil, err
 	}
 
-	return &api.ListWorkspaceTemplatesResponse{
+	resp := &api.ListWorkspaceTemplatesResponse{
 		Count:              int32(len(apiWorkspaceTemplates)),
 		WorkspaceTemplates: apiWorkspaceTemplates,
 		Page:               int32(paginator.Page),
 		Pages:              paginator.CalculatePages(count),
 		TotalCount:         int32(count),
-	}, nil
+	}
+	if len(workspaceTemplates) > 0 {
+		last := workspaceTemplates[len(workspaceTemplates)-1]
+		resp.NextPageToken = paginator.NextToken(len(workspaceTemplates), last.CreatedAt, last.ID)
+	}
+
+	return resp, nil
 }
 
 func (s *WorkspaceTemplateServer) ListWorkspaceTemplateVersions(ctx context.Context, req *api.ListWorkspaceTemplateVersionsRequest) (*api.ListWorkspaceTemplateVersionsResponse, error) {